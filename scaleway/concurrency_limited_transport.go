@@ -0,0 +1,36 @@
+package scaleway
+
+import (
+	"net/http"
+)
+
+// newConcurrencyLimitedTransport creates a http transport that allows at most maxConcurrentRequests requests in flight.
+// If maxConcurrentRequests is lower or equal to 0, the given transport is returned untouched.
+func newConcurrencyLimitedTransport(transport http.RoundTripper, maxConcurrentRequests int) http.RoundTripper {
+	if maxConcurrentRequests <= 0 {
+		return transport
+	}
+
+	return &concurrencyLimitedTransport{
+		transport: transport,
+		slots:     make(chan struct{}, maxConcurrentRequests),
+	}
+}
+
+// concurrencyLimitedTransport throttles the requests sent by the provider, independently of terraform parallelism.
+type concurrencyLimitedTransport struct {
+	transport http.RoundTripper
+	slots     chan struct{}
+}
+
+// RoundTrip waits for a free slot before sending the request.
+func (t *concurrencyLimitedTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	select {
+	case t.slots <- struct{}{}:
+	case <-r.Context().Done():
+		return nil, r.Context().Err()
+	}
+	defer func() { <-t.slots }()
+
+	return t.transport.RoundTrip(r)
+}
@@ -0,0 +1,116 @@
+package scaleway
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/scaleway/scaleway-sdk-go/api/baremetal/v1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+)
+
+const baremetalOSLatestVersion = "latest"
+
+func dataSourceScalewayBaremetalOs() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceScalewayBaremetalOsRead,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Exact name of the desired OS",
+				ConflictsWith: []string{"os_id"},
+			},
+			"version": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Exact version of the desired OS, or latest",
+				ConflictsWith: []string{"os_id"},
+			},
+			"os_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   "The ID of the OS",
+				ValidateFunc:  validationUUIDorUUIDWithLocality(),
+				ConflictsWith: []string{"name", "version"},
+			},
+			"offer_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Only OS compatible with this offer are looked up",
+				ValidateFunc: validationUUIDorUUIDWithLocality(),
+			},
+			"zone": zoneSchema(),
+		},
+	}
+}
+
+func dataSourceScalewayBaremetalOsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	baremetalAPI, fallBackZone, err := baremetalAPIWithZone(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	zone, osID, _ := parseZonedID(datasourceNewZonedID(d.Get("os_id"), fallBackZone))
+
+	req := &baremetal.ListOSRequest{
+		Zone: zone,
+	}
+	if offerID, ok := d.GetOk("offer_id"); ok {
+		req.OfferID = expandStringPtr(expandZonedID(offerID).ID)
+	}
+
+	res, err := baremetalAPI.ListOS(req, scw.WithAllPages(), scw.WithContext(ctx))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	name := d.Get("name").(string)
+	version := d.Get("version").(string)
+	latest := version == "" || version == baremetalOSLatestVersion
+
+	var os *baremetal.OS
+	for _, candidate := range res.Os {
+		if osID != "" {
+			if candidate.ID == osID {
+				os = candidate
+				break
+			}
+			continue
+		}
+		if candidate.Name != name {
+			continue
+		}
+		if latest {
+			if os == nil || baremetalOSVersionLess(os.Version, candidate.Version) {
+				os = candidate
+			}
+			continue
+		}
+		if candidate.Version == version {
+			if os != nil {
+				return diag.FromErr(fmt.Errorf("more than 1 OS found with the name %s and version %s in zone %s", name, version, zone))
+			}
+			os = candidate
+		}
+	}
+	if os == nil {
+		if osID != "" {
+			return diag.FromErr(fmt.Errorf("no OS found with the ID %s in zone %s", osID, zone))
+		}
+		return diag.FromErr(fmt.Errorf("no OS found with the name %s and version %s in zone %s", name, version, zone))
+	}
+
+	zonedID := datasourceNewZonedID(os.ID, zone)
+	d.SetId(zonedID)
+	_ = d.Set("os_id", zonedID)
+	_ = d.Set("zone", zone)
+	_ = d.Set("name", os.Name)
+	_ = d.Set("version", os.Version)
+
+	return nil
+}
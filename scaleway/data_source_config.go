@@ -0,0 +1,61 @@
+package scaleway
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceScalewayConfig() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceScalewayConfigRead,
+
+		Schema: map[string]*schema.Schema{
+			"access_key": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The access key used by the provider",
+			},
+			"organization_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The default organization ID of the provider",
+			},
+			"project_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The default project ID of the provider",
+			},
+			"region": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The default region of the provider",
+			},
+			"zone": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The default zone of the provider",
+			},
+		},
+	}
+}
+
+func dataSourceScalewayConfigRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	meta := m.(*Meta)
+
+	accessKey, _ := meta.scwClient.GetAccessKey()
+	organizationID, _ := meta.scwClient.GetDefaultOrganizationID()
+	projectID, _ := meta.scwClient.GetDefaultProjectID()
+	region, _ := meta.scwClient.GetDefaultRegion()
+	zone, _ := meta.scwClient.GetDefaultZone()
+
+	d.SetId(accessKey)
+	_ = d.Set("access_key", accessKey)
+	_ = d.Set("organization_id", organizationID)
+	_ = d.Set("project_id", projectID)
+	_ = d.Set("region", region.String())
+	_ = d.Set("zone", zone.String())
+
+	return nil
+}
@@ -0,0 +1,128 @@
+package scaleway
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	domain "github.com/scaleway/scaleway-sdk-go/api/domain/v2beta1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+)
+
+func dataSourceScalewayDomainZones() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceScalewayDomainZonesRead,
+
+		Schema: map[string]*schema.Schema{
+			"domain": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only the zones of this domain are listed",
+			},
+			"project_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Zones belonging to this project are listed",
+				ValidateFunc: validationUUID(),
+			},
+			"zones": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "List of DNS zones",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the DNS zone",
+						},
+						"domain": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The domain of the DNS zone",
+						},
+						"subdomain": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The subdomain of the DNS zone",
+						},
+						"status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The status of the DNS zone",
+						},
+						"records_count": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The number of records in the DNS zone",
+						},
+						"ns": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "The name servers of the DNS zone",
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"project_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the project the DNS zone is associated with",
+						},
+						"updated_at": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The date and time of the last update of the DNS zone",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceScalewayDomainZonesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	domainAPI := newDomainAPI(meta)
+
+	res, err := domainAPI.ListDNSZones(&domain.ListDNSZonesRequest{
+		Domain:    d.Get("domain").(string),
+		ProjectID: expandStringPtr(d.Get("project_id")),
+	}, scw.WithAllPages(), scw.WithContext(ctx))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	zones := []map[string]interface{}(nil)
+	for _, zone := range res.DNSZones {
+		zoneName := zone.Domain
+		if zone.Subdomain != "" {
+			zoneName = fmt.Sprintf("%s.%s", zone.Subdomain, zone.Domain)
+		}
+
+		// Only the total count is needed, there is no need to fetch every record.
+		records, err := domainAPI.ListDNSZoneRecords(&domain.ListDNSZoneRecordsRequest{
+			DNSZone:  zoneName,
+			PageSize: scw.Uint32Ptr(1),
+		}, scw.WithContext(ctx))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		zones = append(zones, map[string]interface{}{
+			"id":            zoneName,
+			"domain":        zone.Domain,
+			"subdomain":     zone.Subdomain,
+			"status":        zone.Status.String(),
+			"records_count": int(records.TotalCount),
+			"ns":            zone.Ns,
+			"project_id":    zone.ProjectID,
+			"updated_at":    flattenTime(zone.UpdatedAt),
+		})
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", d.Get("project_id").(string), d.Get("domain").(string)))
+	_ = d.Set("zones", zones)
+
+	return nil
+}
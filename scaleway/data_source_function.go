@@ -0,0 +1,162 @@
+package scaleway
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	function "github.com/scaleway/scaleway-sdk-go/api/function/v1beta1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+)
+
+func dataSourceScalewayFunction() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceScalewayFunctionRead,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				Description:   "The name of the function",
+				ConflictsWith: []string{"function_id"},
+			},
+			"function_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   "The ID of the function",
+				ValidateFunc:  validationUUIDorUUIDWithLocality(),
+				ConflictsWith: []string{"name"},
+			},
+			"namespace_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "The ID of the function namespace",
+				ValidateFunc: validationUUIDorUUIDWithLocality(),
+			},
+			"region": regionSchema(),
+			// Computed
+			"description": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The description of the function",
+			},
+			"runtime": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The runtime of the function",
+			},
+			"handler": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The handler of the function",
+			},
+			"privacy": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The privacy type of the function",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The deployment status of the function",
+			},
+			"error_message": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The error message of the last failed deployment, if any",
+			},
+			"min_scale": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The minimum number of instances of the function",
+			},
+			"max_scale": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The maximum number of instances of the function",
+			},
+			"memory_limit": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The memory limit of the function in MB",
+			},
+			"environment_variables": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "The environment variables of the function",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func dataSourceScalewayFunctionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	api, region, err := functionAPIWithRegion(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	namespaceID := expandRegionalID(d.Get("namespace_id"))
+	if namespaceID.Region != "" {
+		region = namespaceID.Region
+	}
+
+	functionID, ok := d.GetOk("function_id")
+	if !ok {
+		res, err := api.ListFunctions(&function.ListFunctionsRequest{
+			Region:      region,
+			NamespaceID: namespaceID.ID,
+			Name:        expandStringPtr(d.Get("name")),
+		}, scw.WithAllPages(), scw.WithContext(ctx))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		for _, f := range res.Functions {
+			if f.Name == d.Get("name").(string) {
+				if functionID != "" {
+					return diag.FromErr(fmt.Errorf("more than 1 function found with the same name %s", d.Get("name")))
+				}
+				functionID = f.ID
+			}
+		}
+		if functionID == "" {
+			return diag.FromErr(fmt.Errorf("no function found with the name %s", d.Get("name")))
+		}
+	}
+
+	regionalID := datasourceNewRegionalizedID(functionID, region)
+	region, ID, err := parseRegionalID(regionalID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	f, err := api.GetFunction(&function.GetFunctionRequest{
+		Region:     region,
+		FunctionID: ID,
+	}, scw.WithContext(ctx))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(regionalID)
+	_ = d.Set("function_id", regionalID)
+	_ = d.Set("name", f.Name)
+	_ = d.Set("namespace_id", newRegionalIDString(region, f.NamespaceID))
+	_ = d.Set("region", region.String())
+	_ = d.Set("description", flattenStringPtr(f.Description))
+	_ = d.Set("runtime", f.Runtime.String())
+	_ = d.Set("handler", f.Handler)
+	_ = d.Set("privacy", f.Privacy.String())
+	_ = d.Set("status", f.Status.String())
+	_ = d.Set("error_message", flattenStringPtr(f.ErrorMessage))
+	_ = d.Set("min_scale", int(f.MinScale))
+	_ = d.Set("max_scale", int(f.MaxScale))
+	_ = d.Set("memory_limit", int(f.MemoryLimit))
+	_ = d.Set("environment_variables", f.EnvironmentVariables)
+
+	return nil
+}
@@ -0,0 +1,179 @@
+package scaleway
+
+import (
+	"context"
+	"regexp"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/scaleway/scaleway-sdk-go/api/instance/v1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+)
+
+func dataSourceScalewayInstanceImages() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceScalewayInstanceImagesRead,
+
+		Schema: map[string]*schema.Schema{
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Images with a name matching this regular expression are listed.",
+				ValidateFunc: validation.StringIsValidRegExp,
+			},
+			"architecture": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Images of this architecture are listed.",
+				ValidateFunc: validation.StringInSlice([]string{
+					instance.ArchX86_64.String(),
+					instance.ArchArm.String(),
+				}, false),
+			},
+			"public": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "List public images instead of the images of the project.",
+			},
+			"project_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Images belonging to this project are listed.",
+				ValidateFunc: validationUUID(),
+			},
+			"zone": zoneSchema(),
+			"most_recent_image_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the most recent image found",
+			},
+			"images": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "List of images, most recent first",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the image",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the image",
+						},
+						"architecture": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The architecture of the image",
+						},
+						"state": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The state of the image",
+						},
+						"root_volume_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the snapshot used as root volume of the image",
+						},
+						"from_server_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the server the image is based on",
+						},
+						"creation_date": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The date and time of the creation of the image",
+						},
+						"project_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the project the image is associated with",
+						},
+						"organization_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the organization the image is associated with",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceScalewayInstanceImagesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceAPI, zone, err := instanceAPIWithZone(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	res, err := instanceAPI.ListImages(&instance.ListImagesRequest{
+		Zone:    zone,
+		Project: expandStringPtr(d.Get("project_id")),
+		Arch:    expandStringPtr(d.Get("architecture")),
+		Public:  scw.BoolPtr(d.Get("public").(bool)),
+	}, scw.WithAllPages(), scw.WithContext(ctx))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	nameRegex, err := regexp.Compile(d.Get("name_regex").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	images := []*instance.Image(nil)
+	for _, image := range res.Images {
+		if nameRegex.MatchString(image.Name) {
+			images = append(images, image)
+		}
+	}
+	sortInstanceImagesMostRecentFirst(images)
+
+	rawImages := []map[string]interface{}(nil)
+	for _, image := range images {
+		rawImage := map[string]interface{}{
+			"id":              newZonedIDString(zone, image.ID),
+			"name":            image.Name,
+			"architecture":    image.Arch.String(),
+			"state":           image.State.String(),
+			"from_server_id":  image.FromServer,
+			"creation_date":   flattenTime(image.CreationDate),
+			"project_id":      image.Project,
+			"organization_id": image.Organization,
+		}
+		if image.RootVolume != nil {
+			rawImage["root_volume_id"] = newZonedIDString(zone, image.RootVolume.ID)
+		}
+
+		rawImages = append(rawImages, rawImage)
+	}
+
+	d.SetId(zone.String())
+	_ = d.Set("zone", zone.String())
+	_ = d.Set("images", rawImages)
+	if len(rawImages) > 0 {
+		_ = d.Set("most_recent_image_id", rawImages[0]["id"])
+	} else {
+		_ = d.Set("most_recent_image_id", "")
+	}
+
+	return nil
+}
+
+// sortInstanceImagesMostRecentFirst sorts images by creation date, most recent first
+func sortInstanceImagesMostRecentFirst(images []*instance.Image) {
+	sort.SliceStable(images, func(i, j int) bool {
+		if images[i].CreationDate == nil || images[j].CreationDate == nil {
+			return images[j].CreationDate == nil && images[i].CreationDate != nil
+		}
+		return images[i].CreationDate.After(*images[j].CreationDate)
+	})
+}
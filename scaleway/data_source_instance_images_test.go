@@ -0,0 +1,30 @@
+package scaleway
+
+import (
+	"testing"
+	"time"
+
+	"github.com/scaleway/scaleway-sdk-go/api/instance/v1"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSortInstanceImagesMostRecentFirst(t *testing.T) {
+	day := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	newImage := func(id string, date *time.Time) *instance.Image {
+		return &instance.Image{ID: id, CreationDate: date}
+	}
+	nextDay := day.Add(24 * time.Hour)
+
+	images := []*instance.Image{
+		newImage("old", &day),
+		newImage("unknown", nil),
+		newImage("new", &nextDay),
+	}
+	sortInstanceImagesMostRecentFirst(images)
+
+	ids := []string(nil)
+	for _, image := range images {
+		ids = append(ids, image.ID)
+	}
+	assert.Equal(t, []string{"new", "old", "unknown"}, ids)
+}
@@ -0,0 +1,118 @@
+package scaleway
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/scaleway/scaleway-sdk-go/api/instance/v1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+)
+
+func dataSourceScalewayInstancePlacementGroup() *schema.Resource {
+	// Generate datasource schema from resource
+	dsSchema := datasourceSchemaFromResourceSchema(resourceScalewayInstancePlacementGroup().Schema)
+
+	// Set 'Optional' schema elements
+	addOptionalFieldsToSchema(dsSchema, "name", "zone", "project_id")
+
+	dsSchema["name"].ConflictsWith = []string{"placement_group_id"}
+	dsSchema["placement_group_id"] = &schema.Schema{
+		Type:          schema.TypeString,
+		Optional:      true,
+		Description:   "The ID of the placement group",
+		ValidateFunc:  validationUUIDorUUIDWithLocality(),
+		ConflictsWith: []string{"name"},
+	}
+	dsSchema["servers"] = &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "The servers of the placement group",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"id": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The ID of the server",
+				},
+				"name": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The name of the server",
+				},
+				"policy_respected": {
+					Type:        schema.TypeBool,
+					Computed:    true,
+					Description: "Is true when the placement of the server honors the policy of the group",
+				},
+			},
+		},
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourceScalewayInstancePlacementGroupRead,
+
+		Schema: dsSchema,
+	}
+}
+
+func dataSourceScalewayInstancePlacementGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceAPI, zone, err := instanceAPIWithZone(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	placementGroupID, ok := d.GetOk("placement_group_id")
+	if !ok {
+		res, err := instanceAPI.ListPlacementGroups(&instance.ListPlacementGroupsRequest{
+			Zone:    zone,
+			Name:    expandStringPtr(d.Get("name")),
+			Project: expandStringPtr(d.Get("project_id")),
+		}, scw.WithAllPages(), scw.WithContext(ctx))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		for _, pg := range res.PlacementGroups {
+			if pg.Name == d.Get("name").(string) {
+				if placementGroupID != "" {
+					return diag.FromErr(fmt.Errorf("more than 1 placement group found with the same name %s", d.Get("name")))
+				}
+				placementGroupID = pg.ID
+			}
+		}
+		if placementGroupID == "" {
+			return diag.FromErr(fmt.Errorf("no placement group found with the name %s", d.Get("name")))
+		}
+	}
+
+	zonedID := datasourceNewZonedID(placementGroupID, zone)
+	d.SetId(zonedID)
+	_ = d.Set("placement_group_id", zonedID)
+
+	diags := resourceScalewayInstancePlacementGroupRead(ctx, d, meta)
+	if diags.HasError() || d.Id() == "" {
+		return diags
+	}
+
+	placementGroup := expandZonedID(zonedID)
+	res, err := instanceAPI.GetPlacementGroupServers(&instance.GetPlacementGroupServersRequest{
+		Zone:             placementGroup.Zone,
+		PlacementGroupID: placementGroup.ID,
+	}, scw.WithContext(ctx))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	servers := []map[string]interface{}(nil)
+	for _, server := range res.Servers {
+		servers = append(servers, map[string]interface{}{
+			"id":               newZonedIDString(placementGroup.Zone, server.ID),
+			"name":             server.Name,
+			"policy_respected": server.PolicyRespected,
+		})
+	}
+	_ = d.Set("servers", servers)
+
+	return diags
+}
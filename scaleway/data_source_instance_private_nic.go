@@ -0,0 +1,83 @@
+package scaleway
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/scaleway/scaleway-sdk-go/api/instance/v1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+)
+
+func dataSourceScalewayInstancePrivateNIC() *schema.Resource {
+	// Generate datasource schema from resource
+	dsSchema := datasourceSchemaFromResourceSchema(resourceScalewayInstancePrivateNIC().Schema)
+
+	fixDatasourceSchemaFlags(dsSchema, true, "server_id")
+	addOptionalFieldsToSchema(dsSchema, "private_network_id", "zone")
+
+	dsSchema["server_id"].ValidateFunc = validationUUIDorUUIDWithLocality()
+	dsSchema["private_network_id"].ValidateFunc = validationUUIDorUUIDWithLocality()
+	dsSchema["private_network_id"].ConflictsWith = []string{"private_nic_id"}
+	dsSchema["private_nic_id"] = &schema.Schema{
+		Type:          schema.TypeString,
+		Optional:      true,
+		Description:   "The ID of the private NIC",
+		ValidateFunc:  validationUUIDorUUIDWithLocality(),
+		ConflictsWith: []string{"private_network_id"},
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourceScalewayInstancePrivateNICRead,
+
+		Schema: dsSchema,
+	}
+}
+
+func dataSourceScalewayInstancePrivateNICRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceAPI, zone, err := instanceAPIWithZone(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	serverID := expandZonedID(d.Get("server_id")).ID
+
+	privateNICID := expandZonedID(d.Get("private_nic_id")).ID
+	if privateNICID == "" {
+		rawPrivateNetworkID, ok := d.GetOk("private_network_id")
+		if !ok {
+			return diag.FromErr(fmt.Errorf("one of private_nic_id or private_network_id should be set"))
+		}
+		privateNetworkID := expandZonedID(rawPrivateNetworkID).ID
+
+		res, err := instanceAPI.ListPrivateNICs(&instance.ListPrivateNICsRequest{
+			Zone:     zone,
+			ServerID: serverID,
+		}, scw.WithContext(ctx))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		for _, nic := range res.PrivateNics {
+			if nic.PrivateNetworkID == privateNetworkID {
+				privateNICID = nic.ID
+				break
+			}
+		}
+		if privateNICID == "" {
+			return diag.FromErr(fmt.Errorf("no private NIC found on server %s in private network %s", serverID, privateNetworkID))
+		}
+	}
+
+	d.SetId(newZonedNestedIDString(zone, serverID, privateNICID))
+	_ = d.Set("private_nic_id", newZonedIDString(zone, privateNICID))
+
+	diags := resourceScalewayInstancePrivateNICRead(ctx, d, meta)
+	if diags.HasError() {
+		return diags
+	}
+	if d.Id() == "" {
+		return diag.FromErr(fmt.Errorf("private NIC %s not found on server %s", privateNICID, serverID))
+	}
+	return diags
+}
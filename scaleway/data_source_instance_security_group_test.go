@@ -60,6 +60,8 @@ func TestAccScalewayDataSourceInstanceSecurityGroup_Basic(t *testing.T) {
 					resource.TestCheckResourceAttr("data.scaleway_instance_security_group.prod", "name", securityGroupName),
 					testAccCheckScalewayInstanceSecurityGroupExists(tt, "data.scaleway_instance_security_group.stg"),
 					resource.TestCheckResourceAttr("data.scaleway_instance_security_group.stg", "name", securityGroupName),
+					resource.TestCheckResourceAttrPair("data.scaleway_instance_security_group.stg", "inbound_default_policy", "scaleway_instance_security_group.main", "inbound_default_policy"),
+					resource.TestCheckResourceAttrPair("data.scaleway_instance_security_group.stg", "outbound_default_policy", "scaleway_instance_security_group.main", "outbound_default_policy"),
 				),
 			},
 		},
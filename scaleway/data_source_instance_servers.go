@@ -0,0 +1,200 @@
+package scaleway
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/scaleway/scaleway-sdk-go/api/instance/v1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+)
+
+func dataSourceScalewayInstanceServers() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceScalewayInstanceServersRead,
+
+		Schema: map[string]*schema.Schema{
+			"name_prefix": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Servers with a name starting with this prefix are listed.",
+			},
+			"tags": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Servers with all these tags are listed.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"state": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Servers in this state are listed.",
+				ValidateFunc: validation.StringInSlice([]string{
+					instance.ServerStateRunning.String(),
+					instance.ServerStateStopped.String(),
+					instance.ServerStateStoppedInPlace.String(),
+					instance.ServerStateStarting.String(),
+					instance.ServerStateStopping.String(),
+					instance.ServerStateLocked.String(),
+				}, false),
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Servers of this commercial type are listed.",
+			},
+			"project_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Servers belonging to this project are listed.",
+				ValidateFunc: validationUUID(),
+			},
+			"zone": zoneSchema(),
+			"servers": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "List of servers",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the server",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the server",
+						},
+						"type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The commercial type of the server",
+						},
+						"state": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The state of the server",
+						},
+						"tags": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "The tags of the server",
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"public_ip": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The public IPv4 address of the server",
+						},
+						"private_ip": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The Scaleway internal IP address of the server",
+						},
+						"ipv6_address": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The default ipv6 address routed to the server",
+						},
+						"placement_group_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the placement group of the server, if any",
+						},
+						"placement_group_policy_respected": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "True when the placement group policy is respected",
+						},
+						"security_group_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the security group of the server",
+						},
+						"project_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the project the server is associated with",
+						},
+						"organization_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the organization the server is associated with",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceScalewayInstanceServersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceAPI, zone, err := instanceAPIWithZone(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	req := &instance.ListServersRequest{
+		Zone:           zone,
+		Project:        expandStringPtr(d.Get("project_id")),
+		CommercialType: expandStringPtr(d.Get("type")),
+		Tags:           expandStrings(d.Get("tags")),
+	}
+	if state, ok := d.GetOk("state"); ok {
+		serverState := instance.ServerState(state.(string))
+		req.State = &serverState
+	}
+
+	res, err := instanceAPI.ListServers(req, scw.WithAllPages(), scw.WithContext(ctx))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	namePrefix := d.Get("name_prefix").(string)
+
+	servers := []map[string]interface{}(nil)
+	for _, server := range res.Servers {
+		if !strings.HasPrefix(server.Name, namePrefix) {
+			continue
+		}
+
+		rawServer := map[string]interface{}{
+			"id":              newZonedIDString(zone, server.ID),
+			"name":            server.Name,
+			"type":            server.CommercialType,
+			"state":           server.State.String(),
+			"tags":            server.Tags,
+			"private_ip":      flattenStringPtr(server.PrivateIP),
+			"project_id":      server.Project,
+			"organization_id": server.Organization,
+		}
+		if server.PublicIP != nil {
+			rawServer["public_ip"] = server.PublicIP.Address.String()
+		}
+		if server.IPv6 != nil {
+			rawServer["ipv6_address"] = server.IPv6.Address.String()
+		}
+		if server.PlacementGroup != nil {
+			rawServer["placement_group_id"] = newZonedIDString(zone, server.PlacementGroup.ID)
+			rawServer["placement_group_policy_respected"] = server.PlacementGroup.PolicyRespected
+		}
+		if server.SecurityGroup != nil {
+			rawServer["security_group_id"] = newZonedIDString(zone, server.SecurityGroup.ID)
+		}
+
+		servers = append(servers, rawServer)
+	}
+
+	d.SetId(zone.String())
+	_ = d.Set("zone", zone.String())
+	_ = d.Set("servers", servers)
+
+	return nil
+}
@@ -0,0 +1,86 @@
+package scaleway
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/scaleway/scaleway-sdk-go/api/instance/v1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+)
+
+func dataSourceScalewayInstanceSnapshot() *schema.Resource {
+	// Generate datasource schema from resource
+	dsSchema := datasourceSchemaFromResourceSchema(resourceScalewayInstanceSnapshot().Schema)
+
+	// Set 'Optional' schema elements
+	addOptionalFieldsToSchema(dsSchema, "name", "volume_id", "zone", "project_id")
+
+	dsSchema["name"].ConflictsWith = []string{"snapshot_id", "name_prefix"}
+	dsSchema["volume_id"].Description = "The ID of the volume the snapshot was taken from, the most recent matching snapshot is selected"
+	dsSchema["volume_id"].ConflictsWith = []string{"snapshot_id"}
+	dsSchema["name_prefix"] = &schema.Schema{
+		Type:          schema.TypeString,
+		Optional:      true,
+		Description:   "The prefix of the snapshot name, the most recent matching snapshot is selected",
+		ConflictsWith: []string{"snapshot_id", "name"},
+	}
+	dsSchema["snapshot_id"] = &schema.Schema{
+		Type:          schema.TypeString,
+		Optional:      true,
+		Description:   "The ID of the snapshot",
+		ValidateFunc:  validationUUIDorUUIDWithLocality(),
+		ConflictsWith: []string{"name", "name_prefix"},
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourceScalewayInstanceSnapshotRead,
+
+		Schema: dsSchema,
+	}
+}
+
+func dataSourceScalewayInstanceSnapshotRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceAPI, zone, err := instanceAPIWithZone(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	snapshotID, ok := d.GetOk("snapshot_id")
+	if !ok {
+		name := d.Get("name").(string)
+		namePrefix := d.Get("name_prefix").(string)
+		nameFilter := name
+		if namePrefix != "" {
+			nameFilter = namePrefix
+		}
+
+		res, err := instanceAPI.ListSnapshots(&instance.ListSnapshotsRequest{
+			Zone:    zone,
+			Name:    expandStringPtr(nameFilter),
+			Project: expandStringPtr(d.Get("project_id")),
+		}, scw.WithAllPages(), scw.WithContext(ctx))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		volumeID := expandZonedID(d.Get("volume_id")).ID
+		mostRecent := instanceSnapshotMostRecent(res.Snapshots, name, namePrefix, volumeID)
+		if mostRecent == nil {
+			if volumeID != "" && nameFilter == "" {
+				return diag.FromErr(fmt.Errorf("no snapshot found for the volume %s", volumeID))
+			}
+			return diag.FromErr(fmt.Errorf("no snapshot found with the name %s", nameFilter))
+		}
+		if mostRecent.BaseVolume != nil {
+			_ = d.Set("volume_id", newZonedIDString(zone, mostRecent.BaseVolume.ID))
+		}
+		snapshotID = mostRecent.ID
+	}
+
+	zonedID := datasourceNewZonedID(snapshotID, zone)
+	d.SetId(zonedID)
+	_ = d.Set("snapshot_id", zonedID)
+	return resourceScalewayInstanceSnapshotRead(ctx, d, meta)
+}
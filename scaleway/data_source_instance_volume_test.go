@@ -44,6 +44,8 @@ func TestAccScalewayDataSourceInstanceVolume_Basic(t *testing.T) {
 					testAccCheckScalewayInstanceVolumeExists(tt, "data.scaleway_instance_volume.test"),
 					resource.TestCheckResourceAttr("data.scaleway_instance_volume.test", "size_in_gb", "2"),
 					resource.TestCheckResourceAttr("data.scaleway_instance_volume.test", "type", "l_ssd"),
+					resource.TestCheckResourceAttr("data.scaleway_instance_volume.test", "server_id", ""),
+					resource.TestCheckResourceAttrPair("data.scaleway_instance_volume.test2", "name", "scaleway_instance_volume.test", "name"),
 				),
 			},
 		},
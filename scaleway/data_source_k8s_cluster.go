@@ -15,8 +15,11 @@ func dataSourceScalewayK8SCluster() *schema.Resource {
 	dsSchema := datasourceSchemaFromResourceSchema(resourceScalewayK8SCluster().Schema)
 
 	// Set 'Optional' schema elements
-	addOptionalFieldsToSchema(dsSchema, "name", "region")
+	addOptionalFieldsToSchema(dsSchema, "name", "region", "project_id")
 	delete(dsSchema, "delete_additional_resources")
+	delete(dsSchema, "upgrade_pools_sequentially")
+	delete(dsSchema, "pin_patch_version")
+	dsSchema["kubeconfig"].Sensitive = true
 
 	dsSchema["name"].ConflictsWith = []string{"cluster_id"}
 	dsSchema["cluster_id"] = &schema.Schema{
@@ -0,0 +1,93 @@
+package scaleway
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceScalewayK8SKubeconfig() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceScalewayK8SKubeconfigRead,
+
+		Schema: map[string]*schema.Schema{
+			"cluster_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "The ID of the cluster",
+				ValidateFunc: validationUUIDorUUIDWithLocality(),
+			},
+			"auth_method": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     k8sKubeconfigAuthMethodToken,
+				Description: "The authentication method rendered in the kubeconfig file",
+				ValidateFunc: validation.StringInSlice([]string{
+					k8sKubeconfigAuthMethodToken,
+					k8sKubeconfigAuthMethodCLI,
+				}, false),
+			},
+			"region": regionSchema(),
+			"config_file": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The whole kubeconfig file",
+			},
+			"host": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The kubernetes master URL",
+			},
+			"cluster_ca_certificate": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The kubernetes cluster CA certificate",
+			},
+			"token": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The kubernetes cluster admin token",
+			},
+		},
+	}
+}
+
+func dataSourceScalewayK8SKubeconfigRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	k8sAPI, region, err := k8sAPIWithRegion(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	regionalID := datasourceNewRegionalizedID(d.Get("cluster_id"), region)
+	region, clusterID, err := parseRegionalID(regionalID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	kubeconf, err := k8sGetClusterKubeconfig(ctx, k8sAPI, region, clusterID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.Get("auth_method").(string) == k8sKubeconfigAuthMethodCLI {
+		configFile, err := k8sKubeconfigWithCLIAuth([]byte(kubeconf["config_file"].(string)))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		kubeconf["config_file"] = configFile
+		kubeconf["token"] = ""
+	}
+
+	d.SetId(regionalID)
+	_ = d.Set("cluster_id", regionalID)
+	_ = d.Set("region", region.String())
+	for key, value := range kubeconf {
+		_ = d.Set(key, value)
+	}
+
+	return nil
+}
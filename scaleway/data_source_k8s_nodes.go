@@ -0,0 +1,127 @@
+package scaleway
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/scaleway/scaleway-sdk-go/api/k8s/v1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+)
+
+func dataSourceScalewayK8SNodes() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceScalewayK8SNodesRead,
+
+		Schema: map[string]*schema.Schema{
+			"cluster_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "The ID of the cluster",
+				ValidateFunc: validationUUIDorUUIDWithLocality(),
+			},
+			"pool_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Only nodes of this pool are listed",
+				ValidateFunc: validationUUIDorUUIDWithLocality(),
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only nodes with this status are listed",
+				ValidateFunc: validation.StringInSlice([]string{
+					"creating",
+					"not_ready",
+					"ready",
+					"deleting",
+					"deleted",
+					"locked",
+					"rebooting",
+					"creation_error",
+					"upgrading",
+				}, false),
+			},
+			"region": regionSchema(),
+			"nodes": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "List of nodes",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the node",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the node",
+						},
+						"pool_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the pool of the node",
+						},
+						"status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The status of the node",
+						},
+						"public_ip": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The public IPv4 address of the node",
+						},
+						"public_ip_v6": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The public IPv6 address of the node",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceScalewayK8SNodesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	k8sAPI, region, err := k8sAPIWithRegion(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	clusterID := expandRegionalID(d.Get("cluster_id"))
+	if clusterID.Region != "" {
+		region = clusterID.Region
+	}
+
+	req := &k8s.ListNodesRequest{
+		Region:    region,
+		ClusterID: clusterID.ID,
+		Status:    k8s.NodeStatus(d.Get("status").(string)),
+	}
+	if poolID, ok := d.GetOk("pool_id"); ok {
+		req.PoolID = expandStringPtr(expandID(poolID))
+	}
+
+	res, err := k8sAPI.ListNodes(req, scw.WithAllPages(), scw.WithContext(ctx))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// convertNodes takes care of nodes without public IPs
+	nodes := convertNodes(res)
+	for i, node := range res.Nodes {
+		nodes[i]["id"] = newRegionalIDString(region, node.ID)
+		nodes[i]["pool_id"] = newRegionalIDString(region, node.PoolID)
+	}
+
+	d.SetId(newRegionalIDString(region, clusterID.ID))
+	_ = d.Set("region", region.String())
+	_ = d.Set("nodes", nodes)
+
+	return nil
+}
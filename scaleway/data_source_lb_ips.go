@@ -0,0 +1,126 @@
+package scaleway
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/scaleway/scaleway-sdk-go/api/lb/v1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+)
+
+func dataSourceScalewayLbIPs() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceScalewayLbIPsRead,
+
+		Schema: map[string]*schema.Schema{
+			"reverse": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "IPs with a reverse containing this string are listed.",
+			},
+			"attached": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "If set, only IPs attached (true) or not attached (false) to a load balancer are listed.",
+			},
+			"project_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "IPs belonging to this project are listed.",
+				ValidateFunc: validationUUID(),
+			},
+			"zone": zoneSchema(),
+			"ips": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "List of load balancer IPs",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the IP",
+						},
+						"ip_address": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The IP address",
+						},
+						"reverse": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The reverse domain name of the IP",
+						},
+						"lb_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the load balancer attached to the IP, if any",
+						},
+						"attached": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the IP is attached to a load balancer",
+						},
+						"project_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the project the IP is associated with",
+						},
+						"organization_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the organization the IP is associated with",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceScalewayLbIPsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	lbAPI, zone, err := lbAPIWithZone(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	res, err := lbAPI.ListIPs(&lb.ZonedAPIListIPsRequest{
+		Zone:      zone,
+		ProjectID: expandStringPtr(d.Get("project_id")),
+	}, scw.WithAllPages(), scw.WithContext(ctx))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	reverse := d.Get("reverse").(string)
+	attached, filterAttached := d.GetOkExists("attached")
+
+	ips := []map[string]interface{}(nil)
+	for _, ip := range res.IPs {
+		if reverse != "" && !strings.Contains(ip.Reverse, reverse) {
+			continue
+		}
+		isAttached := ip.LBID != nil
+		if filterAttached && attached.(bool) != isAttached {
+			continue
+		}
+
+		ips = append(ips, map[string]interface{}{
+			"id":              newZonedIDString(zone, ip.ID),
+			"ip_address":      ip.IPAddress,
+			"reverse":         ip.Reverse,
+			"lb_id":           flattenStringPtr(ip.LBID),
+			"attached":        isAttached,
+			"project_id":      ip.ProjectID,
+			"organization_id": ip.OrganizationID,
+		})
+	}
+
+	d.SetId(zone.String())
+	_ = d.Set("zone", zone.String())
+	_ = d.Set("ips", ips)
+
+	return nil
+}
@@ -0,0 +1,93 @@
+package scaleway
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/scaleway/scaleway-sdk-go/api/instance/v1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+)
+
+func dataSourceScalewayPrices() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceScalewayPricesRead,
+
+		Schema: map[string]*schema.Schema{
+			"zone": zoneSchema(),
+			"instance_server_types": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "List prices of the instance server types available in the zone",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"commercial_type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The commercial type of the server",
+						},
+						"hourly_price": {
+							Type:        schema.TypeFloat,
+							Computed:    true,
+							Description: "The hourly price of the server type in euros",
+						},
+						"monthly_price": {
+							Type:        schema.TypeFloat,
+							Computed:    true,
+							Description: "The monthly price of the server type in euros",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceScalewayPricesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceAPI, zone, err := instanceAPIWithZone(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	res, err := instanceAPI.ListServersTypes(&instance.ListServersTypesRequest{
+		Zone: zone,
+	}, scw.WithAllPages(), scw.WithContext(ctx))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	commercialTypes := make([]string, 0, len(res.Servers))
+	for commercialType := range res.Servers {
+		commercialTypes = append(commercialTypes, commercialType)
+	}
+	sort.Strings(commercialTypes)
+
+	serverTypes := []map[string]interface{}(nil)
+	for _, commercialType := range commercialTypes {
+		serverType := res.Servers[commercialType]
+		serverTypes = append(serverTypes, map[string]interface{}{
+			"commercial_type": commercialType,
+			"hourly_price":    flattenPrice(serverType.HourlyPrice),
+			"monthly_price":   flattenPrice(serverType.MonthlyPrice),
+		})
+	}
+
+	d.SetId(zone.String())
+	_ = d.Set("zone", zone.String())
+	_ = d.Set("instance_server_types", serverTypes)
+
+	return nil
+}
+
+// flattenPrice converts a float32 price to a float64 without precision loss
+func flattenPrice(price float32) float64 {
+	priceF64, err := strconv.ParseFloat(fmt.Sprintf("%f", price), 64)
+	if err != nil {
+		// should never happen
+		return 0
+	}
+	return priceF64
+}
@@ -0,0 +1,122 @@
+package scaleway
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/scaleway/scaleway-sdk-go/api/registry/v1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+)
+
+func dataSourceScalewayRegistryNamespaces() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceScalewayRegistryNamespacesRead,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Namespaces with a name like it are listed.",
+			},
+			"project_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Namespaces belonging to this project are listed.",
+				ValidateFunc: validationUUID(),
+			},
+			"region": regionSchema(),
+			"namespaces": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "List of registry namespaces",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the namespace",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the namespace",
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The description of the namespace",
+						},
+						"endpoint": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The endpoint reachable by docker",
+						},
+						"is_public": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the images of the namespace are public by default",
+						},
+						"status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The status of the namespace",
+						},
+						"image_count": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The number of images in the namespace",
+						},
+						"project_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the project the namespace is associated with",
+						},
+						"organization_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the organization the namespace is associated with",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceScalewayRegistryNamespacesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	api, region, err := registryAPIWithRegion(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	res, err := api.ListNamespaces(&registry.ListNamespacesRequest{
+		Region:    region,
+		Name:      expandStringPtr(d.Get("name")),
+		ProjectID: expandStringPtr(d.Get("project_id")),
+	}, scw.WithAllPages(), scw.WithContext(ctx))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	namespaces := []map[string]interface{}(nil)
+	for _, ns := range res.Namespaces {
+		namespaces = append(namespaces, map[string]interface{}{
+			"id":              newRegionalIDString(region, ns.ID),
+			"name":            ns.Name,
+			"description":     ns.Description,
+			"endpoint":        ns.Endpoint,
+			"is_public":       ns.IsPublic,
+			"status":          ns.Status.String(),
+			"image_count":     int(ns.ImageCount),
+			"project_id":      ns.ProjectID,
+			"organization_id": ns.OrganizationID,
+		})
+	}
+
+	d.SetId(region.String())
+	_ = d.Set("region", region.String())
+	_ = d.Set("namespaces", namespaces)
+
+	return nil
+}
@@ -15,7 +15,7 @@ func dataSourceScalewayVPCPublicGateway() *schema.Resource {
 	dsSchema := datasourceSchemaFromResourceSchema(resourceScalewayVPCPublicGateway().Schema)
 
 	// Set 'Optional' schema elements
-	addOptionalFieldsToSchema(dsSchema, "name")
+	addOptionalFieldsToSchema(dsSchema, "name", "zone", "project_id")
 
 	dsSchema["name"].ConflictsWith = []string{"public_gateway_id"}
 	dsSchema["public_gateway_id"] = &schema.Schema{
@@ -42,8 +42,9 @@ func dataSourceScalewayVPCPublicGatewayRead(ctx context.Context, d *schema.Resou
 	if !ok {
 		res, err := vpcgwAPI.ListGateways(
 			&vpcgw.ListGatewaysRequest{
-				Name: expandStringPtr(d.Get("name").(string)),
-				Zone: zone,
+				Name:      expandStringPtr(d.Get("name").(string)),
+				Zone:      zone,
+				ProjectID: expandStringPtr(d.Get("project_id")),
 			}, scw.WithContext(ctx))
 		if err != nil {
 			return diag.FromErr(err)
@@ -0,0 +1,175 @@
+package scaleway
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	vpcgw "github.com/scaleway/scaleway-sdk-go/api/vpcgw/v1beta1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+)
+
+func dataSourceScalewayVPCPublicGateways() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceScalewayVPCPublicGatewaysRead,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Public gateways with a name like it are listed.",
+			},
+			"tags": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Public gateways with these exact tags are listed.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"project_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Public gateways belonging to this project are listed.",
+				ValidateFunc: validationUUID(),
+			},
+			"zone": zoneSchema(),
+			"gateways": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "List of public gateways",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the public gateway",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the public gateway",
+						},
+						"type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The type of the public gateway",
+						},
+						"status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The status of the public gateway",
+						},
+						"tags": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "The tags associated with the public gateway",
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"ip_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the IP attached to the public gateway",
+						},
+						"ip_address": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The IP address attached to the public gateway",
+						},
+						"private_network_ids": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "The IDs of the private networks attached to the public gateway",
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"upstream_dns_servers": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "The recursive DNS servers of the public gateway",
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"project_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the project the public gateway is associated with",
+						},
+						"organization_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the organization the public gateway is associated with",
+						},
+						"created_at": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The date and time of the creation of the public gateway",
+						},
+						"updated_at": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The date and time of the last update of the public gateway",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceScalewayVPCPublicGatewaysRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	vpcgwAPI, zone, err := vpcgwAPIWithZone(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	res, err := vpcgwAPI.ListGateways(&vpcgw.ListGatewaysRequest{
+		Zone:      zone,
+		Name:      expandStringPtr(d.Get("name")),
+		Tags:      expandStrings(d.Get("tags")),
+		ProjectID: expandStringPtr(d.Get("project_id")),
+	}, scw.WithAllPages(), scw.WithContext(ctx))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	gateways := []map[string]interface{}(nil)
+	for _, gateway := range res.Gateways {
+		rawGateway := map[string]interface{}{
+			"id":                   newZonedIDString(zone, gateway.ID),
+			"name":                 gateway.Name,
+			"status":               gateway.Status.String(),
+			"tags":                 gateway.Tags,
+			"upstream_dns_servers": gateway.UpstreamDNSServers,
+			"project_id":           gateway.ProjectID,
+			"organization_id":      gateway.OrganizationID,
+			"created_at":           flattenTime(gateway.CreatedAt),
+			"updated_at":           flattenTime(gateway.UpdatedAt),
+		}
+		if gateway.Type != nil {
+			rawGateway["type"] = gateway.Type.Name
+		}
+		if gateway.IP != nil {
+			rawGateway["ip_id"] = newZonedIDString(zone, gateway.IP.ID)
+			rawGateway["ip_address"] = gateway.IP.Address.String()
+		}
+
+		privateNetworkIDs := []string(nil)
+		for _, gatewayNetwork := range gateway.GatewayNetworks {
+			privateNetworkIDs = append(privateNetworkIDs, newZonedIDString(zone, gatewayNetwork.PrivateNetworkID))
+		}
+		rawGateway["private_network_ids"] = privateNetworkIDs
+
+		gateways = append(gateways, rawGateway)
+	}
+
+	d.SetId(zone.String())
+	_ = d.Set("zone", zone.String())
+	_ = d.Set("gateways", gateways)
+
+	return nil
+}
@@ -23,6 +23,26 @@ var (
 	DefaultWaitRetryInterval *time.Duration
 )
 
+// waitRetryIntervalOverride returns the retry interval configured on the provider, or DefaultWaitRetryInterval if none is configured.
+// It returns nil when neither is set so that waiters keep their own default.
+func waitRetryIntervalOverride(meta interface{}) *time.Duration {
+	if m, ok := meta.(*Meta); ok && m.waitRetryInterval != nil {
+		return scw.TimeDurationPtr(*m.waitRetryInterval)
+	}
+	if DefaultWaitRetryInterval != nil {
+		return scw.TimeDurationPtr(*DefaultWaitRetryInterval)
+	}
+	return nil
+}
+
+// waitRetryInterval returns the retry interval a waiter should use, waitRetryIntervalOverride takes precedence over the given default.
+func waitRetryInterval(meta interface{}, defaultInterval time.Duration) *time.Duration {
+	if interval := waitRetryIntervalOverride(meta); interval != nil {
+		return interval
+	}
+	return scw.TimeDurationPtr(defaultInterval)
+}
+
 // RegionalID represents an ID that is linked with a region, eg fr-par/11111111-1111-1111-1111-111111111111
 type RegionalID struct {
 	ID     string
@@ -247,6 +267,12 @@ func is409Error(err error) bool {
 	return isHTTPCodeError(err, http.StatusConflict) || xerrors.As(err, &transientStateError)
 }
 
+// isOutOfStockError returns true if err is an out of stock error
+func isOutOfStockError(err error) bool {
+	outOfStockError := &scw.OutOfStockError{}
+	return xerrors.As(err, &outOfStockError)
+}
+
 // organizationIDSchema returns a standard schema for a organization_id
 func organizationIDSchema() *schema.Schema {
 	return &schema.Schema{
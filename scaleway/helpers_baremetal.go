@@ -99,3 +99,41 @@ func flattenBaremetalIPs(ips []*baremetal.IP) interface{} {
 	}
 	return flattendIPs
 }
+
+// baremetalOSVersionLess reports whether OS version a is older than OS version b.
+// Versions are compared on their numeric parts, so "10" is newer than "9" and
+// "20.04 LTS (Focal Fossa)" is newer than "18.04 LTS (Bionic Beaver)".
+func baremetalOSVersionLess(a, b string) bool {
+	aParts := extractVersionNumbers(a)
+	bParts := extractVersionNumbers(b)
+	for i := 0; i < len(aParts) && i < len(bParts); i++ {
+		if aParts[i] != bParts[i] {
+			return aParts[i] < bParts[i]
+		}
+	}
+	if len(aParts) != len(bParts) {
+		return len(aParts) < len(bParts)
+	}
+	return a < b
+}
+
+// extractVersionNumbers returns the numeric parts of a version string.
+func extractVersionNumbers(version string) []int {
+	numbers := []int(nil)
+	current, inNumber := 0, false
+	for _, r := range version {
+		if r >= '0' && r <= '9' {
+			current = current*10 + int(r-'0')
+			inNumber = true
+			continue
+		}
+		if inNumber {
+			numbers = append(numbers, current)
+			current, inNumber = 0, false
+		}
+	}
+	if inNumber {
+		numbers = append(numbers, current)
+	}
+	return numbers
+}
@@ -0,0 +1,53 @@
+package scaleway
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBaremetalOSVersionLess(t *testing.T) {
+	tests := []struct {
+		name     string
+		a        string
+		b        string
+		expected bool
+	}{
+		{
+			name:     "single number",
+			a:        "9",
+			b:        "10",
+			expected: true,
+		},
+		{
+			name:     "ubuntu releases",
+			a:        "18.04 LTS (Bionic Beaver)",
+			b:        "20.04 LTS (Focal Fossa)",
+			expected: true,
+		},
+		{
+			name:     "newer first",
+			a:        "20.04 LTS (Focal Fossa)",
+			b:        "18.04 LTS (Bionic Beaver)",
+			expected: false,
+		},
+		{
+			name:     "more precise version",
+			a:        "8",
+			b:        "8.4",
+			expected: true,
+		},
+		{
+			name:     "equal versions",
+			a:        "11",
+			b:        "11",
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, baremetalOSVersionLess(tt.a, tt.b))
+		})
+	}
+}
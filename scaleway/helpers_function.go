@@ -0,0 +1,19 @@
+package scaleway
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	function "github.com/scaleway/scaleway-sdk-go/api/function/v1beta1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+)
+
+// functionAPIWithRegion returns a new serverless function API and the region.
+func functionAPIWithRegion(d *schema.ResourceData, m interface{}) (*function.API, scw.Region, error) {
+	meta := m.(*Meta)
+	api := function.NewAPI(meta.scwClient)
+
+	region, err := extractRegion(d, meta)
+	if err != nil {
+		return nil, "", err
+	}
+	return api, region, nil
+}
@@ -1,16 +1,28 @@
 package scaleway
 
 import (
+	"bytes"
+	"compress/gzip"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
+	"io"
+	"mime/multipart"
+	"net"
+	"net/textproto"
 	"sort"
+	"strings"
 	"time"
 
 	"github.com/dustin/go-humanize"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/scaleway/scaleway-sdk-go/api/instance/v1"
+	"github.com/scaleway/scaleway-sdk-go/api/marketplace/v1"
 	"github.com/scaleway/scaleway-sdk-go/api/vpc/v1"
 	"github.com/scaleway/scaleway-sdk-go/scw"
+	scwvalidation "github.com/scaleway/scaleway-sdk-go/validation"
 )
 
 const (
@@ -18,14 +30,19 @@ const (
 	InstanceServerStateStarted = "started"
 	InstanceServerStateStandby = "standby"
 
+	InstanceServerStopModePoweroff  = "poweroff"
+	InstanceServerStopModeTerminate = "terminate"
+
 	defaultInstanceServerWaitTimeout        = 10 * time.Minute
 	defaultInstanceVolumeDeleteTimeout      = 10 * time.Minute
 	defaultInstanceSecurityGroupTimeout     = 1 * time.Minute
 	defaultInstanceSecurityGroupRuleTimeout = 1 * time.Minute
 	defaultInstancePlacementGroupTimeout    = 1 * time.Minute
 	defaultInstanceIPTimeout                = 1 * time.Minute
+	defaultInstanceIPReverseDNSTimeout      = 10 * time.Minute
 
 	defaultInstanceSnapshotWaitTimeout = 1 * time.Hour
+	defaultInstanceImageTimeout        = 1 * time.Hour
 )
 
 // instanceAPIWithZone returns a new instance API and the zone for a Create request
@@ -64,6 +81,32 @@ func instanceAPIWithZoneAndNestedID(m interface{}, zonedNestedID string) (*insta
 	return instanceAPI, zone, innerID, outerID, nil
 }
 
+// instanceServerCandidateZones returns the ordered zones in which a server can be created.
+// Provider fallback zones are only used when the zone is not set in the configuration
+// and the server does not reference zoned resources (image ID, IP, volumes, security group...).
+func instanceServerCandidateZones(d *schema.ResourceData, m interface{}, zone scw.Zone) []scw.Zone {
+	zones := []scw.Zone{zone}
+
+	if _, zoneIsSet := d.GetOk("zone"); zoneIsSet {
+		return zones
+	}
+	for _, key := range []string{"ip_id", "placement_group_id", "additional_volume_ids", "security_group_id", "private_network"} {
+		if _, ok := d.GetOk(key); ok {
+			return zones
+		}
+	}
+	if scwvalidation.IsUUID(expandZonedID(d.Get("image")).ID) {
+		return zones
+	}
+
+	for _, fallbackZone := range m.(*Meta).fallbackZones {
+		if fallbackZone != zone {
+			zones = append(zones, fallbackZone)
+		}
+	}
+	return zones
+}
+
 // orderVolumes return an ordered slice based on the volume map key "0", "1", "2",...
 func orderVolumes(v map[string]*instance.Volume) []*instance.Volume {
 	var indexes []string
@@ -122,7 +165,8 @@ func serverStateExpand(rawState string) (instance.ServerState, error) {
 	return apiState, nil
 }
 
-func reachState(ctx context.Context, instanceAPI *instance.API, zone scw.Zone, serverID string, toState instance.ServerState) error {
+// reachState runs the actions needed to move a server to the given state, stopTimeout bounds the wait on stop actions.
+func reachState(ctx context.Context, meta interface{}, instanceAPI *instance.API, zone scw.Zone, serverID string, toState instance.ServerState, stopTimeout time.Duration) error {
 	response, err := instanceAPI.GetServer(&instance.GetServerRequest{
 		Zone:     zone,
 		ServerID: serverID,
@@ -156,7 +200,7 @@ func reachState(ctx context.Context, instanceAPI *instance.API, zone scw.Zone, s
 			_, err = instanceAPI.WaitForVolume(&instance.WaitForVolumeRequest{
 				Zone:          zone,
 				VolumeID:      volume.ID,
-				RetryInterval: DefaultWaitRetryInterval,
+				RetryInterval: waitRetryIntervalOverride(meta),
 			})
 			if err != nil {
 				return err
@@ -165,12 +209,16 @@ func reachState(ctx context.Context, instanceAPI *instance.API, zone scw.Zone, s
 	}
 
 	for _, a := range actions {
+		timeout := defaultInstanceServerWaitTimeout
+		if a == instance.ServerActionPoweroff || a == instance.ServerActionStopInPlace {
+			timeout = stopTimeout
+		}
 		err = instanceAPI.ServerActionAndWait(&instance.ServerActionAndWaitRequest{
 			ServerID:      serverID,
 			Action:        a,
 			Zone:          zone,
-			Timeout:       scw.TimeDurationPtr(defaultInstanceServerWaitTimeout),
-			RetryInterval: DefaultWaitRetryInterval,
+			Timeout:       scw.TimeDurationPtr(timeout),
+			RetryInterval: waitRetryIntervalOverride(meta),
 		})
 		if err != nil {
 			return err
@@ -179,6 +227,73 @@ func reachState(ctx context.Context, instanceAPI *instance.API, zone scw.Zone, s
 	return nil
 }
 
+// expandInstanceServerShutdownTimeout returns the maximum duration to wait for the server to stop
+func expandInstanceServerShutdownTimeout(d *schema.ResourceData) time.Duration {
+	timeout, err := time.ParseDuration(d.Get("shutdown_timeout").(string))
+	if err != nil || timeout <= 0 {
+		return defaultInstanceServerWaitTimeout
+	}
+	return timeout
+}
+
+// instanceServerVolumesDeletable returns true when all the volumes of the server are deleted with it,
+// the terminate action deletes every volume attached to the server.
+func instanceServerVolumesDeletable(d *schema.ResourceData) bool {
+	_, rootVolumeAttributeSet := d.GetOk("root_volume")
+	if rootVolumeAttributeSet && !d.Get("root_volume.0.delete_on_termination").(bool) {
+		return false
+	}
+	return len(d.Get("additional_volume_ids").([]interface{})) == 0
+}
+
+// instanceServerImageUUID returns the UUID of the image of a server, image labels are resolved for the commercial type of the server.
+func instanceServerImageUUID(meta interface{}, zone scw.Zone, commercialType string, image string) (string, error) {
+	imageUUID := expandZonedID(image).ID
+	if scwvalidation.IsUUID(imageUUID) {
+		return imageUUID, nil
+	}
+
+	marketPlaceAPI := marketplace.NewAPI(meta.(*Meta).scwClient)
+	imageUUID, err := marketPlaceAPI.GetLocalImageIDByLabel(&marketplace.GetLocalImageIDByLabelRequest{
+		CommercialType: commercialType,
+		Zone:           zone,
+		ImageLabel:     imageUUID,
+	})
+	if err != nil {
+		return "", fmt.Errorf("could not get image '%s': %s", newZonedID(zone, imageUUID), err)
+	}
+	return imageUUID, nil
+}
+
+// instanceVolumeResize grows a block volume, which can be attached to a running server.
+func instanceVolumeResize(ctx context.Context, meta interface{}, instanceAPI *instance.API, zone scw.Zone, volumeID string, sizeInGB int) error {
+	_, err := instanceAPI.WaitForVolume(&instance.WaitForVolumeRequest{
+		VolumeID:      volumeID,
+		Zone:          zone,
+		RetryInterval: waitRetryIntervalOverride(meta),
+	}, scw.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+
+	volumeSizeInBytes := scw.Size(uint64(sizeInGB) * gb)
+	_, err = instanceAPI.UpdateVolume(&instance.UpdateVolumeRequest{
+		VolumeID: volumeID,
+		Zone:     zone,
+		Size:     &volumeSizeInBytes,
+	}, scw.WithContext(ctx))
+	if err != nil {
+		return fmt.Errorf("couldn't resize volume: %s", err)
+	}
+
+	_, err = instanceAPI.WaitForVolume(&instance.WaitForVolumeRequest{
+		VolumeID:      volumeID,
+		Zone:          zone,
+		RetryInterval: waitRetryIntervalOverride(meta),
+	}, scw.WithContext(ctx))
+	return err
+}
+
 // getServerType is a util to get a instance.ServerType by its commercialType
 func getServerType(apiInstance *instance.API, zone scw.Zone, commercialType string) *instance.ServerType {
 	serverType := (*instance.ServerType)(nil)
@@ -396,3 +511,238 @@ func (ph *privateNICsHandler) get(key string) (interface{}, error) {
 		"zone":        locality,
 	}, nil
 }
+
+// instanceCloudInitBoundary is the boundary of the multipart cloud-init documents built by the provider.
+// It is fixed so that the same parts always produce the same document.
+const instanceCloudInitBoundary = "MIMEBOUNDARY"
+
+// instanceCloudInitPart is a part of a multipart cloud-init document
+type instanceCloudInitPart struct {
+	ContentType string
+	Filename    string
+	Content     string
+}
+
+func expandInstanceCloudInitParts(raw interface{}) []instanceCloudInitPart {
+	parts := []instanceCloudInitPart(nil)
+	for i, rawPart := range raw.([]interface{}) {
+		part := rawPart.(map[string]interface{})
+		filename := part["filename"].(string)
+		if filename == "" {
+			filename = fmt.Sprintf("part-%03d", i+1)
+		}
+		parts = append(parts, instanceCloudInitPart{
+			ContentType: part["content_type"].(string),
+			Filename:    filename,
+			Content:     part["content"].(string),
+		})
+	}
+	return parts
+}
+
+// instanceCloudInitMultipart assembles the given parts in a MIME multipart document understood by cloud-init.
+// When compress is true the document is gzipped, cloud-init decompresses it on boot.
+func instanceCloudInitMultipart(parts []instanceCloudInitPart, compress bool) ([]byte, error) {
+	buf := &bytes.Buffer{}
+	_, _ = fmt.Fprintf(buf, "Content-Type: multipart/mixed; boundary=\"%s\"\r\nMIME-Version: 1.0\r\n\r\n", instanceCloudInitBoundary)
+
+	writer := multipart.NewWriter(buf)
+	if err := writer.SetBoundary(instanceCloudInitBoundary); err != nil {
+		return nil, err
+	}
+	for _, part := range parts {
+		header := textproto.MIMEHeader{}
+		header.Set("Content-Type", part.ContentType)
+		header.Set("Content-Transfer-Encoding", "7bit")
+		header.Set("Content-Disposition", fmt.Sprintf("attachment; filename=\"%s\"", part.Filename))
+		header.Set("MIME-Version", "1.0")
+
+		partWriter, err := writer.CreatePart(header)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := partWriter.Write([]byte(part.Content)); err != nil {
+			return nil, err
+		}
+	}
+	if err := writer.Close(); err != nil {
+		return nil, err
+	}
+
+	if !compress {
+		return buf.Bytes(), nil
+	}
+
+	gzipped := &bytes.Buffer{}
+	gzipWriter := gzip.NewWriter(gzipped)
+	if _, err := gzipWriter.Write(buf.Bytes()); err != nil {
+		return nil, err
+	}
+	if err := gzipWriter.Close(); err != nil {
+		return nil, err
+	}
+	return gzipped.Bytes(), nil
+}
+
+// instanceUserDataHash returns the hash used to detect changes of a user data
+func instanceUserDataHash(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// expandInstanceServerUserData returns all the user data of a server: the user_data map, and the cloud-init
+// document built from either cloud_init or cloud_init_part.
+func expandInstanceServerUserData(d *schema.ResourceData) (map[string]io.Reader, error) {
+	userData := make(map[string]io.Reader)
+
+	if rawUserData, ok := d.GetOk("user_data"); ok {
+		for key, value := range rawUserData.(map[string]interface{}) {
+			userData[key] = bytes.NewBufferString(value.(string))
+		}
+	}
+
+	// cloud init script is set in user data
+	if cloudInit, ok := d.GetOk("cloud_init"); ok {
+		userData["cloud-init"] = bytes.NewBufferString(cloudInit.(string))
+	}
+
+	if rawParts, ok := d.GetOk("cloud_init_part"); ok {
+		if _, exist := userData["cloud-init"]; exist {
+			return nil, fmt.Errorf("cloud_init_part cannot be used with a cloud-init key in user_data")
+		}
+		cloudInit, err := instanceCloudInitMultipart(expandInstanceCloudInitParts(rawParts), d.Get("cloud_init_gzip").(bool))
+		if err != nil {
+			return nil, err
+		}
+		userData["cloud-init"] = bytes.NewBuffer(cloudInit)
+	}
+
+	return userData, nil
+}
+
+// waitInstanceIPReverseDNSResolves waits for the reverse DNS name to resolve to the given IP address
+func waitInstanceIPReverseDNSResolves(ctx context.Context, reverse string, address net.IP, timeout time.Duration) error {
+	return resource.RetryContext(ctx, timeout, func() *resource.RetryError {
+		addresses, err := net.DefaultResolver.LookupHost(ctx, strings.TrimSuffix(reverse, "."))
+		if err != nil {
+			return resource.RetryableError(fmt.Errorf("reverse %s does not resolve to %s: %w", reverse, address, err))
+		}
+		for _, resolved := range addresses {
+			if address.Equal(net.ParseIP(resolved)) {
+				return nil
+			}
+		}
+		return resource.RetryableError(fmt.Errorf("reverse %s resolves to %s instead of %s", reverse, strings.Join(addresses, ", "), address))
+	})
+}
+
+// instanceSnapshotScheduleName returns the name of a snapshot taken by a snapshot schedule
+func instanceSnapshotScheduleName(prefix string, volumeID string, date time.Time) string {
+	return fmt.Sprintf("%s-%s-%s", prefix, volumeID, date.UTC().Format("20060102-150405"))
+}
+
+// instanceSnapshotScheduleSnapshots returns the snapshots taken by a snapshot schedule for the given volumes, or all the volumes when nil, oldest first
+func instanceSnapshotScheduleSnapshots(snapshots []*instance.Snapshot, prefix string, volumeIDs []string) []*instance.Snapshot {
+	volumes := make(map[string]bool, len(volumeIDs))
+	for _, volumeID := range volumeIDs {
+		volumes[volumeID] = true
+	}
+
+	scheduled := []*instance.Snapshot(nil)
+	for _, snapshot := range snapshots {
+		if snapshot.BaseVolume == nil || snapshot.CreationDate == nil {
+			continue
+		}
+		if volumeIDs != nil && !volumes[snapshot.BaseVolume.ID] {
+			continue
+		}
+		if !strings.HasPrefix(snapshot.Name, prefix+"-"+snapshot.BaseVolume.ID+"-") {
+			continue
+		}
+		scheduled = append(scheduled, snapshot)
+	}
+
+	sort.SliceStable(scheduled, func(i, j int) bool {
+		return scheduled[i].CreationDate.Before(*scheduled[j].CreationDate)
+	})
+	return scheduled
+}
+
+// instanceSnapshotScheduleExpired returns the snapshots exceeding the retention count of their volume,
+// snapshots must be sorted oldest first.
+func instanceSnapshotScheduleExpired(snapshots []*instance.Snapshot, retention int) []*instance.Snapshot {
+	count := make(map[string]int)
+	for _, snapshot := range snapshots {
+		count[snapshot.BaseVolume.ID]++
+	}
+
+	expired := []*instance.Snapshot(nil)
+	for _, snapshot := range snapshots {
+		if count[snapshot.BaseVolume.ID] > retention {
+			expired = append(expired, snapshot)
+			count[snapshot.BaseVolume.ID]--
+		}
+	}
+	return expired
+}
+
+// instanceSnapshotScheduleRemoved returns the snapshots of the volumes that are not part of the schedule anymore
+func instanceSnapshotScheduleRemoved(snapshots []*instance.Snapshot, volumeIDs []string) []*instance.Snapshot {
+	volumes := make(map[string]bool, len(volumeIDs))
+	for _, volumeID := range volumeIDs {
+		volumes[volumeID] = true
+	}
+
+	removed := []*instance.Snapshot(nil)
+	for _, snapshot := range snapshots {
+		if !volumes[snapshot.BaseVolume.ID] {
+			removed = append(removed, snapshot)
+		}
+	}
+	return removed
+}
+
+// instanceSnapshotScheduleVolumeIDs returns the zoned IDs of the volumes of the snapshots, in the order of their first snapshot
+func instanceSnapshotScheduleVolumeIDs(zone scw.Zone, snapshots []*instance.Snapshot) []string {
+	seen := make(map[string]bool)
+	volumeIDs := []string(nil)
+	for _, snapshot := range snapshots {
+		if seen[snapshot.BaseVolume.ID] {
+			continue
+		}
+		seen[snapshot.BaseVolume.ID] = true
+		volumeIDs = append(volumeIDs, newZonedIDString(zone, snapshot.BaseVolume.ID))
+	}
+	return volumeIDs
+}
+
+// instanceSnapshotScheduleLastRun returns the creation date of the latest snapshot, snapshots must be sorted oldest first.
+func instanceSnapshotScheduleLastRun(snapshots []*instance.Snapshot) *time.Time {
+	if len(snapshots) == 0 {
+		return nil
+	}
+	return snapshots[len(snapshots)-1].CreationDate
+}
+
+// instanceSnapshotMostRecent returns the most recent snapshot matching the name, or the name prefix, and the base volume when they are set.
+func instanceSnapshotMostRecent(snapshots []*instance.Snapshot, name string, namePrefix string, volumeID string) *instance.Snapshot {
+	var mostRecent *instance.Snapshot
+	for _, snapshot := range snapshots {
+		if namePrefix != "" && !strings.HasPrefix(snapshot.Name, namePrefix) {
+			continue
+		}
+		if name != "" && snapshot.Name != name {
+			continue
+		}
+		if namePrefix == "" && name == "" && volumeID == "" {
+			continue
+		}
+		if volumeID != "" && (snapshot.BaseVolume == nil || snapshot.BaseVolume.ID != volumeID) {
+			continue
+		}
+		if mostRecent == nil || (snapshot.CreationDate != nil && mostRecent.CreationDate != nil && snapshot.CreationDate.After(*mostRecent.CreationDate)) {
+			mostRecent = snapshot
+		}
+	}
+	return mostRecent
+}
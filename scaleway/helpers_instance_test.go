@@ -0,0 +1,161 @@
+package scaleway
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io/ioutil"
+	"mime/multipart"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/scaleway/scaleway-sdk-go/api/instance/v1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInstanceCloudInitMultipart(t *testing.T) {
+	parts := []instanceCloudInitPart{
+		{
+			ContentType: "text/x-shellscript",
+			Filename:    "init.sh",
+			Content:     "#!/bin/sh\necho hello\n",
+		},
+		{
+			ContentType: "text/cloud-config",
+			Filename:    "cloud-config.yaml",
+			Content:     "#cloud-config\napt_update: true\n",
+		},
+	}
+
+	document, err := instanceCloudInitMultipart(parts, false)
+	require.NoError(t, err)
+
+	// The same parts must always produce the same document
+	again, err := instanceCloudInitMultipart(parts, false)
+	require.NoError(t, err)
+	assert.Equal(t, instanceUserDataHash(document), instanceUserDataHash(again))
+
+	header := "Content-Type: multipart/mixed; boundary=\"MIMEBOUNDARY\"\r\nMIME-Version: 1.0\r\n\r\n"
+	require.True(t, strings.HasPrefix(string(document), header))
+
+	reader := multipart.NewReader(bytes.NewReader(document[len(header):]), instanceCloudInitBoundary)
+	for _, expected := range parts {
+		part, err := reader.NextPart()
+		require.NoError(t, err)
+		assert.Equal(t, expected.ContentType, part.Header.Get("Content-Type"))
+		assert.Equal(t, expected.Filename, part.FileName())
+		content, err := ioutil.ReadAll(part)
+		require.NoError(t, err)
+		assert.Equal(t, expected.Content, string(content))
+	}
+	_, err = reader.NextPart()
+	assert.Error(t, err)
+
+	compressed, err := instanceCloudInitMultipart(parts, true)
+	require.NoError(t, err)
+	gzipReader, err := gzip.NewReader(bytes.NewReader(compressed))
+	require.NoError(t, err)
+	uncompressed, err := ioutil.ReadAll(gzipReader)
+	require.NoError(t, err)
+	assert.Equal(t, document, uncompressed)
+}
+
+func TestExpandInstanceCloudInitParts(t *testing.T) {
+	parts := expandInstanceCloudInitParts([]interface{}{
+		map[string]interface{}{
+			"content_type": "text/x-shellscript",
+			"content":      "#!/bin/sh\n",
+			"filename":     "",
+		},
+		map[string]interface{}{
+			"content_type": "text/cloud-config",
+			"content":      "#cloud-config\n",
+			"filename":     "config.yaml",
+		},
+	})
+
+	assert.Equal(t, []instanceCloudInitPart{
+		{ContentType: "text/x-shellscript", Filename: "part-001", Content: "#!/bin/sh\n"},
+		{ContentType: "text/cloud-config", Filename: "config.yaml", Content: "#cloud-config\n"},
+	}, parts)
+}
+
+func TestInstanceSnapshotSchedule(t *testing.T) {
+	day := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	newSnapshot := func(id string, name string, volumeID string, date time.Time) *instance.Snapshot {
+		return &instance.Snapshot{
+			ID:           id,
+			Name:         name,
+			BaseVolume:   &instance.SnapshotBaseVolume{ID: volumeID},
+			CreationDate: &date,
+		}
+	}
+
+	assert.Equal(t, "daily-vol1-20220101-000000", instanceSnapshotScheduleName("daily", "vol1", day))
+
+	snapshots := instanceSnapshotScheduleSnapshots([]*instance.Snapshot{
+		newSnapshot("3", instanceSnapshotScheduleName("daily", "vol1", day.Add(48*time.Hour)), "vol1", day.Add(48*time.Hour)),
+		newSnapshot("1", instanceSnapshotScheduleName("daily", "vol1", day), "vol1", day),
+		newSnapshot("2", instanceSnapshotScheduleName("daily", "vol1", day.Add(24*time.Hour)), "vol1", day.Add(24*time.Hour)),
+		newSnapshot("4", instanceSnapshotScheduleName("daily", "vol2", day), "vol2", day),
+		// Snapshots of other schedules, other volumes or taken manually are ignored
+		newSnapshot("5", instanceSnapshotScheduleName("weekly", "vol1", day), "vol1", day),
+		newSnapshot("6", instanceSnapshotScheduleName("daily", "vol3", day), "vol3", day),
+		newSnapshot("7", "daily-manual", "vol1", day),
+	}, "daily", []string{"vol1", "vol2"})
+
+	ids := []string(nil)
+	for _, snapshot := range snapshots {
+		ids = append(ids, snapshot.ID)
+	}
+	assert.Equal(t, []string{"1", "4", "2", "3"}, ids)
+
+	assert.Equal(t, day.Add(48*time.Hour), *instanceSnapshotScheduleLastRun(snapshots))
+	assert.Nil(t, instanceSnapshotScheduleLastRun(nil))
+
+	all := instanceSnapshotScheduleSnapshots([]*instance.Snapshot{
+		newSnapshot("1", instanceSnapshotScheduleName("daily", "vol1", day), "vol1", day),
+		newSnapshot("2", instanceSnapshotScheduleName("daily", "vol3", day.Add(24*time.Hour)), "vol3", day.Add(24*time.Hour)),
+		newSnapshot("3", instanceSnapshotScheduleName("weekly", "vol1", day), "vol1", day),
+	}, "daily", nil)
+	assert.Len(t, all, 2)
+	assert.Equal(t, []string{"fr-par-1/vol1", "fr-par-1/vol3"}, instanceSnapshotScheduleVolumeIDs(scw.ZoneFrPar1, all))
+
+	removed := instanceSnapshotScheduleRemoved(all, []string{"vol1"})
+	assert.Len(t, removed, 1)
+	assert.Equal(t, "2", removed[0].ID)
+
+	expired := instanceSnapshotScheduleExpired(snapshots, 2)
+	assert.Len(t, expired, 1)
+	assert.Equal(t, "1", expired[0].ID)
+	assert.Empty(t, instanceSnapshotScheduleExpired(snapshots, 3))
+}
+
+func TestInstanceSnapshotMostRecent(t *testing.T) {
+	day := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	newSnapshot := func(id string, name string, volumeID string, date time.Time) *instance.Snapshot {
+		return &instance.Snapshot{
+			ID:           id,
+			Name:         name,
+			BaseVolume:   &instance.SnapshotBaseVolume{ID: volumeID},
+			CreationDate: &date,
+		}
+	}
+	snapshots := []*instance.Snapshot{
+		newSnapshot("1", "nightly-1", "vol1", day),
+		newSnapshot("2", "nightly-2", "vol1", day.Add(24*time.Hour)),
+		newSnapshot("3", "nightly-1", "vol2", day.Add(48*time.Hour)),
+		newSnapshot("4", "manual", "vol1", day.Add(72*time.Hour)),
+	}
+
+	assert.Equal(t, "3", instanceSnapshotMostRecent(snapshots, "nightly-1", "", "").ID)
+	assert.Equal(t, "3", instanceSnapshotMostRecent(snapshots, "", "nightly-", "").ID)
+	assert.Equal(t, "4", instanceSnapshotMostRecent(snapshots, "", "", "vol1").ID)
+	assert.Equal(t, "2", instanceSnapshotMostRecent(snapshots, "", "nightly-", "vol1").ID)
+	assert.Equal(t, "1", instanceSnapshotMostRecent(snapshots, "nightly-1", "", "vol1").ID)
+	assert.Nil(t, instanceSnapshotMostRecent(snapshots, "", "weekly-", ""))
+	assert.Nil(t, instanceSnapshotMostRecent(snapshots, "", "", "vol3"))
+	assert.Nil(t, instanceSnapshotMostRecent(snapshots, "", "", ""))
+}
@@ -25,11 +25,11 @@ func iotAPIWithRegionAndID(m interface{}, id string) (*iot.API, scw.Region, stri
 	return iotAPI, region, ID, err
 }
 
-func waitIotHub(iotAPI *iot.API, region scw.Region, hubID string, desiredStates ...iot.HubStatus) error {
+func waitIotHub(meta interface{}, iotAPI *iot.API, region scw.Region, hubID string, desiredStates ...iot.HubStatus) error {
 	hub, err := iotAPI.WaitForHub(&iot.WaitForHubRequest{
 		HubID:         hubID,
 		Region:        region,
-		RetryInterval: DefaultWaitRetryInterval,
+		RetryInterval: waitRetryIntervalOverride(meta),
 	})
 	if err != nil {
 		return err
@@ -3,13 +3,20 @@ package scaleway
 import (
 	"context"
 	"fmt"
+	"regexp"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
 
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/scaleway/scaleway-sdk-go/api/instance/v1"
 	"github.com/scaleway/scaleway-sdk-go/api/k8s/v1"
 	"github.com/scaleway/scaleway-sdk-go/scw"
+	"gopkg.in/yaml.v2"
 )
 
 type KubeconfigStruct struct {
@@ -28,15 +35,29 @@ type KubeconfigStruct struct {
 			User    string `yaml:"user"`
 		} `yaml:"context"`
 	} `yaml:"contexts"`
-	Kind  string `yaml:"kind"`
-	Users []struct {
+	CurrentContext string `yaml:"current-context,omitempty"`
+	Kind           string `yaml:"kind"`
+	Users          []struct {
 		Name string `yaml:"name"`
 		User struct {
-			Token string `yaml:"token"`
+			Token string          `yaml:"token,omitempty"`
+			Exec  *KubeconfigExec `yaml:"exec,omitempty"`
 		} `yaml:"user"`
 	} `yaml:"users"`
 }
 
+// KubeconfigExec is the exec credential plugin configuration of a kubeconfig user.
+type KubeconfigExec struct {
+	APIVersion string   `yaml:"apiVersion"`
+	Command    string   `yaml:"command"`
+	Args       []string `yaml:"args"`
+}
+
+const (
+	k8sKubeconfigAuthMethodToken = "token"
+	k8sKubeconfigAuthMethodCLI   = "cli"
+)
+
 const (
 	defaultK8SClusterTimeout             = 10 * time.Minute
 	defaultK8SPoolTimeout                = 10 * time.Minute
@@ -102,30 +123,43 @@ func k8sGetLatestVersionFromMinor(ctx context.Context, k8sAPI *k8s.API, region s
 	return "", fmt.Errorf("no available upstream version found for %s", version)
 }
 
-func waitK8SCluster(ctx context.Context, k8sAPI *k8s.API, region scw.Region, clusterID string) (*k8s.Cluster, error) {
+// k8sValidateClusterVersion checks that a minor version x.y is used when auto upgrade is enabled, a minor version can also be used without it.
+func k8sValidateClusterVersion(version string, autoUpgradeEnabled bool) error {
+	if autoUpgradeEnabled && len(strings.Split(version, ".")) != 2 {
+		return fmt.Errorf("a minor version x.y must be used when auto upgrade is enabled")
+	}
+	return nil
+}
+
+// k8sClusterTracksLatestPatch returns true when the cluster is upgraded to the latest patch of its minor version by the provider.
+func k8sClusterTracksLatestPatch(version string, autoUpgradeEnabled bool, pinPatchVersion bool) bool {
+	return len(strings.Split(version, ".")) == 2 && !autoUpgradeEnabled && !pinPatchVersion
+}
+
+func waitK8SCluster(ctx context.Context, meta interface{}, k8sAPI *k8s.API, region scw.Region, clusterID string, timeout time.Duration) (*k8s.Cluster, error) {
 	return k8sAPI.WaitForCluster(&k8s.WaitForClusterRequest{
 		ClusterID:     clusterID,
 		Region:        region,
-		Timeout:       scw.TimeDurationPtr(K8SClusterWaitForPoolRequiredTimeout),
-		RetryInterval: DefaultWaitRetryInterval,
+		Timeout:       scw.TimeDurationPtr(timeout),
+		RetryInterval: waitRetryIntervalOverride(meta),
 	}, scw.WithContext(ctx))
 }
 
-func waitK8SClusterPool(ctx context.Context, k8sAPI *k8s.API, region scw.Region, clusterID string) (*k8s.Cluster, error) {
+func waitK8SClusterPool(ctx context.Context, meta interface{}, k8sAPI *k8s.API, region scw.Region, clusterID string, timeout time.Duration) (*k8s.Cluster, error) {
 	return k8sAPI.WaitForClusterPool(&k8s.WaitForClusterRequest{
 		ClusterID:     clusterID,
 		Region:        region,
-		Timeout:       scw.TimeDurationPtr(K8SClusterWaitForPoolRequiredTimeout),
-		RetryInterval: DefaultWaitRetryInterval,
+		Timeout:       scw.TimeDurationPtr(timeout),
+		RetryInterval: waitRetryIntervalOverride(meta),
 	}, scw.WithContext(ctx))
 }
 
-func waitK8SClusterDeleted(ctx context.Context, k8sAPI *k8s.API, region scw.Region, clusterID string) error {
+func waitK8SClusterDeleted(ctx context.Context, meta interface{}, k8sAPI *k8s.API, region scw.Region, clusterID string, timeout time.Duration) error {
 	cluster, err := k8sAPI.WaitForCluster(&k8s.WaitForClusterRequest{
 		ClusterID:     clusterID,
 		Region:        region,
-		Timeout:       scw.TimeDurationPtr(K8SClusterWaitForDeletedTimeout),
-		RetryInterval: DefaultWaitRetryInterval,
+		Timeout:       scw.TimeDurationPtr(timeout),
+		RetryInterval: waitRetryIntervalOverride(meta),
 	}, scw.WithContext(ctx))
 	if err != nil {
 		if is404Error(err) {
@@ -137,12 +171,12 @@ func waitK8SClusterDeleted(ctx context.Context, k8sAPI *k8s.API, region scw.Regi
 	return fmt.Errorf("cluster %s has state %s, wants %s", clusterID, cluster.Status, k8s.ClusterStatusDeleted)
 }
 
-func waitK8SPoolReady(ctx context.Context, k8sAPI *k8s.API, region scw.Region, poolID string) error {
+func waitK8SPoolReady(ctx context.Context, meta interface{}, k8sAPI *k8s.API, region scw.Region, poolID string, timeout time.Duration) error {
 	pool, err := k8sAPI.WaitForPool(&k8s.WaitForPoolRequest{
 		PoolID:        poolID,
 		Region:        region,
-		Timeout:       scw.TimeDurationPtr(K8SPoolWaitForReadyTimeout),
-		RetryInterval: DefaultWaitRetryInterval,
+		Timeout:       scw.TimeDurationPtr(timeout),
+		RetryInterval: waitRetryIntervalOverride(meta),
 	}, scw.WithContext(ctx))
 
 	if err != nil {
@@ -155,6 +189,283 @@ func waitK8SPoolReady(ctx context.Context, k8sAPI *k8s.API, region scw.Region, p
 	return nil
 }
 
+// waitK8SPoolNodesReady waits for the pool to have all its nodes ready in Kubernetes,
+// which may happen after the pool itself is ready.
+func waitK8SPoolNodesReady(ctx context.Context, k8sAPI *k8s.API, region scw.Region, poolID string, timeout time.Duration) error {
+	return resource.RetryContext(ctx, timeout, func() *resource.RetryError {
+		pool, err := k8sAPI.GetPool(&k8s.GetPoolRequest{
+			Region: region,
+			PoolID: poolID,
+		}, scw.WithContext(ctx))
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		nodes, err := k8sAPI.ListNodes(&k8s.ListNodesRequest{
+			Region:    region,
+			ClusterID: pool.ClusterID,
+			PoolID:    &pool.ID,
+		}, scw.WithAllPages(), scw.WithContext(ctx))
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		if uint32(len(nodes.Nodes)) < pool.Size {
+			return resource.RetryableError(fmt.Errorf("pool %s has %d nodes, wants %d", poolID, len(nodes.Nodes), pool.Size))
+		}
+
+		for _, node := range nodes.Nodes {
+			if node.Status != k8s.NodeStatusReady {
+				return resource.RetryableError(fmt.Errorf("node %s has status %s, wants %s", node.Name, node.Status, k8s.NodeStatusReady))
+			}
+		}
+
+		return nil
+	})
+}
+
+// k8sGetClusterKubeconfig fetches the kubeconfig of a cluster and flattens it.
+func k8sGetClusterKubeconfig(ctx context.Context, k8sAPI *k8s.API, region scw.Region, clusterID string) (map[string]interface{}, error) {
+	kubeconfig, err := k8sAPI.GetClusterKubeConfig(&k8s.GetClusterKubeConfigRequest{
+		Region:    region,
+		ClusterID: clusterID,
+	}, scw.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	kubeconfigServer, err := kubeconfig.GetServer()
+	if err != nil {
+		return nil, err
+	}
+
+	kubeconfigCa, err := kubeconfig.GetCertificateAuthorityData()
+	if err != nil {
+		return nil, err
+	}
+
+	kubeconfigToken, err := kubeconfig.GetToken()
+	if err != nil {
+		return nil, err
+	}
+
+	kubeconf := map[string]interface{}{}
+	kubeconf["config_file"] = string(kubeconfig.GetRaw())
+	kubeconf["host"] = kubeconfigServer
+	kubeconf["cluster_ca_certificate"] = kubeconfigCa
+	kubeconf["token"] = kubeconfigToken
+
+	return kubeconf, nil
+}
+
+// k8sKubeconfigWithCLIAuth rewrites a raw kubeconfig so that its users get their
+// credentials from the scw CLI exec plugin instead of an embedded static token.
+func k8sKubeconfigWithCLIAuth(rawKubeconfig []byte) (string, error) {
+	kubeconfig := KubeconfigStruct{}
+	err := yaml.Unmarshal(rawKubeconfig, &kubeconfig)
+	if err != nil {
+		return "", err
+	}
+
+	for i := range kubeconfig.Users {
+		kubeconfig.Users[i].User.Token = ""
+		kubeconfig.Users[i].User.Exec = &KubeconfigExec{
+			APIVersion: "client.authentication.k8s.io/v1beta1",
+			Command:    "scw",
+			Args:       []string{"k8s", "exec-credential"},
+		}
+	}
+
+	config, err := yaml.Marshal(kubeconfig)
+	if err != nil {
+		return "", err
+	}
+	return string(config), nil
+}
+
+// k8sKubeconfigNamedLists are the kubeconfig lists whose entries are identified by their name.
+var k8sKubeconfigNamedLists = []string{"clusters", "contexts", "users"}
+
+func k8sKubeconfigEntryName(entry interface{}) string {
+	entryMap, ok := entry.(map[interface{}]interface{})
+	if !ok {
+		return ""
+	}
+	name, _ := entryMap["name"].(string)
+	return name
+}
+
+func k8sKubeconfigUnmarshal(rawKubeconfig []byte) (map[string]interface{}, error) {
+	kubeconfig := map[string]interface{}{}
+	err := yaml.Unmarshal(rawKubeconfig, &kubeconfig)
+	if err != nil {
+		return nil, err
+	}
+	return kubeconfig, nil
+}
+
+// k8sKubeconfigCurrentContext returns the current context of a raw kubeconfig.
+func k8sKubeconfigCurrentContext(rawKubeconfig []byte) (string, error) {
+	kubeconfig, err := k8sKubeconfigUnmarshal(rawKubeconfig)
+	if err != nil {
+		return "", err
+	}
+	currentContext, _ := kubeconfig["current-context"].(string)
+	return currentContext, nil
+}
+
+// k8sKubeconfigRename renames the cluster, context and user of a single cluster kubeconfig
+// so that they can be identified when merged in another kubeconfig.
+func k8sKubeconfigRename(rawKubeconfig []byte, name string) ([]byte, error) {
+	kubeconfig, err := k8sKubeconfigUnmarshal(rawKubeconfig)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, list := range k8sKubeconfigNamedLists {
+		entries, _ := kubeconfig[list].([]interface{})
+		for _, entry := range entries {
+			entryMap, ok := entry.(map[interface{}]interface{})
+			if !ok {
+				continue
+			}
+			entryMap["name"] = name
+			if contextMap, ok := entryMap["context"].(map[interface{}]interface{}); ok {
+				contextMap["cluster"] = name
+				contextMap["user"] = name
+			}
+		}
+	}
+	kubeconfig["current-context"] = name
+
+	return yaml.Marshal(kubeconfig)
+}
+
+// k8sKubeconfigMerge adds the clusters, contexts and users of kubeconfig to existing,
+// replacing the entries with the same name, and switches to the kubeconfig current context.
+func k8sKubeconfigMerge(existing []byte, rawKubeconfig []byte) ([]byte, error) {
+	base, err := k8sKubeconfigUnmarshal(existing)
+	if err != nil {
+		return nil, err
+	}
+	kubeconfig, err := k8sKubeconfigUnmarshal(rawKubeconfig)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, list := range k8sKubeconfigNamedLists {
+		baseEntries, _ := base[list].([]interface{})
+		entries, _ := kubeconfig[list].([]interface{})
+		for _, entry := range entries {
+			replaced := false
+			for i, baseEntry := range baseEntries {
+				if k8sKubeconfigEntryName(baseEntry) == k8sKubeconfigEntryName(entry) {
+					baseEntries[i] = entry
+					replaced = true
+					break
+				}
+			}
+			if !replaced {
+				baseEntries = append(baseEntries, entry)
+			}
+		}
+		base[list] = baseEntries
+	}
+
+	for _, key := range []string{"apiVersion", "kind"} {
+		if _, exist := base[key]; !exist {
+			base[key] = kubeconfig[key]
+		}
+	}
+	base["current-context"] = kubeconfig["current-context"]
+
+	return yaml.Marshal(base)
+}
+
+// k8sKubeconfigRemove removes the clusters, contexts and users named name from a kubeconfig.
+func k8sKubeconfigRemove(existing []byte, name string) ([]byte, error) {
+	base, err := k8sKubeconfigUnmarshal(existing)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, list := range k8sKubeconfigNamedLists {
+		baseEntries, _ := base[list].([]interface{})
+		entries := []interface{}{}
+		for _, entry := range baseEntries {
+			if k8sKubeconfigEntryName(entry) != name {
+				entries = append(entries, entry)
+			}
+		}
+		base[list] = entries
+	}
+
+	if currentContext, _ := base["current-context"].(string); currentContext == name {
+		delete(base, "current-context")
+	}
+
+	return yaml.Marshal(base)
+}
+
+// k8sKubeconfigHasContext returns true if the kubeconfig contains a context named name.
+func k8sKubeconfigHasContext(rawKubeconfig []byte, name string) (bool, error) {
+	kubeconfig, err := k8sKubeconfigUnmarshal(rawKubeconfig)
+	if err != nil {
+		return false, err
+	}
+
+	contexts, _ := kubeconfig["contexts"].([]interface{})
+	for _, kubeContext := range contexts {
+		if k8sKubeconfigEntryName(kubeContext) == name {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// k8sUpgradePoolsSequentially upgrades the pools of a cluster to the given version
+// one after the other, oldest first, waiting for each pool to be ready before
+// upgrading the next one. Each pool is upgraded following its own upgrade policy.
+func k8sUpgradePoolsSequentially(ctx context.Context, meta interface{}, k8sAPI *k8s.API, region scw.Region, clusterID string, version string, timeout time.Duration) error {
+	res, err := k8sAPI.ListPools(&k8s.ListPoolsRequest{
+		Region:    region,
+		ClusterID: clusterID,
+	}, scw.WithAllPages(), scw.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+
+	pools := res.Pools
+	sort.SliceStable(pools, func(i, j int) bool {
+		if pools[i].CreatedAt == nil || pools[j].CreatedAt == nil {
+			return false
+		}
+		return pools[i].CreatedAt.Before(*pools[j].CreatedAt)
+	})
+
+	for _, pool := range pools {
+		if pool.Version == version {
+			continue
+		}
+
+		_, err = k8sAPI.UpgradePool(&k8s.UpgradePoolRequest{
+			Region:  region,
+			PoolID:  pool.ID,
+			Version: version,
+		}, scw.WithContext(ctx))
+		if err != nil {
+			return fmt.Errorf("failed to upgrade pool %s: %w", pool.ID, err)
+		}
+
+		err = waitK8SPoolReady(ctx, meta, k8sAPI, region, pool.ID, timeout)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // convert a list of nodes to a list of map
 func convertNodes(res *k8s.ListNodesResponse) []map[string]interface{} {
 	var result []map[string]interface{}
@@ -189,6 +500,83 @@ func getNodes(ctx context.Context, k8sAPI *k8s.API, pool *k8s.Pool) ([]map[strin
 	return convertNodes(nodes), nil
 }
 
+// k8sNodeServer returns the instance server of a pool node, servers are named after their node.
+// It returns nil when the server of the node is not created yet.
+func k8sNodeServer(ctx context.Context, instanceAPI *instance.API, zone scw.Zone, node *k8s.Node) (*instance.Server, error) {
+	res, err := instanceAPI.ListServers(&instance.ListServersRequest{
+		Zone: zone,
+		Name: scw.StringPtr(node.Name),
+	}, scw.WithAllPages(), scw.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	// The API matches names partially
+	for _, server := range res.Servers {
+		if server.Name == node.Name {
+			return server, nil
+		}
+	}
+	return nil, nil
+}
+
+// k8sPropagatePoolTags adds the tags to the instance servers of the pool nodes, keeping the tags already set on the servers.
+func k8sPropagatePoolTags(ctx context.Context, k8sAPI *k8s.API, instanceAPI *instance.API, region scw.Region, poolID string, tags []string) error {
+	pool, err := k8sAPI.GetPool(&k8s.GetPoolRequest{
+		Region: region,
+		PoolID: poolID,
+	}, scw.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+
+	nodes, err := k8sAPI.ListNodes(&k8s.ListNodesRequest{
+		Region:    region,
+		ClusterID: pool.ClusterID,
+		PoolID:    &pool.ID,
+	}, scw.WithAllPages(), scw.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+
+	for _, node := range nodes.Nodes {
+		server, err := k8sNodeServer(ctx, instanceAPI, pool.Zone, node)
+		if err != nil {
+			return err
+		}
+		// nodes being created have no server yet
+		if server == nil {
+			continue
+		}
+
+		serverTags := append([]string(nil), server.Tags...)
+		existingTags := make(map[string]bool, len(serverTags))
+		for _, tag := range serverTags {
+			existingTags[tag] = true
+		}
+		for _, tag := range tags {
+			if !existingTags[tag] {
+				existingTags[tag] = true
+				serverTags = append(serverTags, tag)
+			}
+		}
+		if len(serverTags) == len(server.Tags) {
+			continue
+		}
+
+		_, err = instanceAPI.UpdateServer(&instance.UpdateServerRequest{
+			Zone:     pool.Zone,
+			ServerID: server.ID,
+			Tags:     scw.StringsPtr(serverTags),
+		}, scw.WithContext(ctx))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func clusterAutoscalerConfigFlatten(cluster *k8s.Cluster) []map[string]interface{} {
 	autoscalerConfig := map[string]interface{}{}
 	autoscalerConfig["disable_scale_down"] = cluster.AutoscalerConfig.ScaleDownDisabled
@@ -244,6 +632,25 @@ func poolUpgradePolicyFlatten(pool *k8s.Pool) []map[string]interface{} {
 	return []map[string]interface{}{upgradePolicy}
 }
 
+// k8sPoolValidateSizes checks that min_size <= size <= max_size, max_size is only checked when it is set.
+func k8sPoolValidateSizes(size, minSize, maxSize int, maxSizeSet bool) error {
+	if minSize > size {
+		return fmt.Errorf("min_size (%d) must be lower than or equal to size (%d)", minSize, size)
+	}
+	if maxSizeSet && size > maxSize {
+		return fmt.Errorf("size (%d) must be lower than or equal to max_size (%d)", size, maxSize)
+	}
+	return nil
+}
+
+// k8sPoolValidateUpgradePolicy checks that an upgrade can make progress, by creating or replacing at least one node at a time.
+func k8sPoolValidateUpgradePolicy(maxSurge, maxUnavailable int) error {
+	if maxSurge+maxUnavailable <= 0 {
+		return fmt.Errorf("upgrade_policy max_surge + max_unavailable must be greater than 0")
+	}
+	return nil
+}
+
 func expandKubeletArgs(args interface{}) map[string]string {
 	kubeletArgs := map[string]string{}
 
@@ -263,3 +670,83 @@ func flattenKubeletArgs(args map[string]string) map[string]interface{} {
 
 	return kubeletArgs
 }
+
+// k8sKubeletArgsTypes lists the value type of the kubelet arguments, as returned in the available_kubelet_args of a version.
+// It is only used to check the values at plan time, the API validates the arguments available in the cluster version.
+var k8sKubeletArgsTypes = map[string]string{
+	"containerLogMaxFiles": "uint16",
+	"containerLogMaxSize":  "quantity",
+	"cpuCFSQuota":          "bool",
+	"cpuCFSQuotaPeriod":    "duration",
+	"cpuManagerPolicy":     "enum:none|static",
+	"maxPods":              "uint16",
+}
+
+var k8sKubeletArgQuantityRegexp = regexp.MustCompile(`^[0-9]+(Ki|Mi|Gi)?$`)
+
+// k8sValidateKubeletArg checks a kubelet argument value against its type, unknown types are accepted.
+func k8sValidateKubeletArg(argType string, value string) error {
+	switch {
+	case argType == "uint16":
+		if _, err := strconv.ParseUint(value, 10, 16); err != nil {
+			return fmt.Errorf("expected a positive integer, got %q", value)
+		}
+	case argType == "bool":
+		if _, err := strconv.ParseBool(value); err != nil {
+			return fmt.Errorf("expected a boolean, got %q", value)
+		}
+	case argType == "duration":
+		if _, err := time.ParseDuration(value); err != nil {
+			return fmt.Errorf("expected a duration (e.g. 100ms), got %q", value)
+		}
+	case argType == "quantity":
+		if !k8sKubeletArgQuantityRegexp.MatchString(value) {
+			return fmt.Errorf("expected a quantity (e.g. 10Mi), got %q", value)
+		}
+	case strings.HasPrefix(argType, "enum:"):
+		values := strings.Split(strings.TrimPrefix(argType, "enum:"), "|")
+		for _, v := range values {
+			if v == value {
+				return nil
+			}
+		}
+		return fmt.Errorf("expected one of %s, got %q", strings.Join(values, ", "), value)
+	}
+	return nil
+}
+
+// validateKubeletArgs checks the values of the known kubelet arguments, the other ones are left to the API.
+func validateKubeletArgs() schema.SchemaValidateDiagFunc {
+	return func(i interface{}, path cty.Path) diag.Diagnostics {
+		args, ok := i.(map[string]interface{})
+		if !ok {
+			return diag.Errorf("expected kubelet_args to be a map, got %T", i)
+		}
+
+		keys := make([]string, 0, len(args))
+		for key := range args {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		var diags diag.Diagnostics
+		for _, key := range keys {
+			argType, exist := k8sKubeletArgsTypes[key]
+			if !exist {
+				continue
+			}
+
+			value, _ := args[key].(string)
+			if err := k8sValidateKubeletArg(argType, value); err != nil {
+				diags = append(diags, diag.Diagnostic{
+					Severity:      diag.Error,
+					Summary:       fmt.Sprintf("invalid value for kubelet argument %q", key),
+					Detail:        err.Error(),
+					AttributePath: path.IndexString(key),
+				})
+			}
+		}
+
+		return diags
+	}
+}
@@ -0,0 +1,220 @@
+package scaleway
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v2"
+)
+
+func TestK8SKubeconfigWithCLIAuth(t *testing.T) {
+	rawKubeconfig := []byte(`apiVersion: v1
+clusters:
+- name: my-cluster
+  cluster:
+    certificate-authority-data: Y2E=
+    server: https://11111111-1111-1111-1111-111111111111.api.k8s.fr-par.scw.cloud:6443
+contexts:
+- name: admin@my-cluster
+  context:
+    cluster: my-cluster
+    user: my-cluster-admin
+current-context: admin@my-cluster
+kind: Config
+preferences: {}
+users:
+- name: my-cluster-admin
+  user:
+    token: secret-token
+`)
+
+	config, err := k8sKubeconfigWithCLIAuth(rawKubeconfig)
+	require.NoError(t, err)
+	assert.NotContains(t, config, "secret-token")
+
+	kubeconfig := KubeconfigStruct{}
+	require.NoError(t, yaml.Unmarshal([]byte(config), &kubeconfig))
+
+	assert.Equal(t, "admin@my-cluster", kubeconfig.CurrentContext)
+	require.Len(t, kubeconfig.Clusters, 1)
+	assert.Equal(t, "https://11111111-1111-1111-1111-111111111111.api.k8s.fr-par.scw.cloud:6443", kubeconfig.Clusters[0].Cluster.Server)
+	assert.Equal(t, "Y2E=", kubeconfig.Clusters[0].Cluster.CertificateAuthorityData)
+
+	require.Len(t, kubeconfig.Users, 1)
+	assert.Equal(t, "my-cluster-admin", kubeconfig.Users[0].Name)
+	assert.Empty(t, kubeconfig.Users[0].User.Token)
+	require.NotNil(t, kubeconfig.Users[0].User.Exec)
+	assert.Equal(t, "scw", kubeconfig.Users[0].User.Exec.Command)
+	assert.Equal(t, []string{"k8s", "exec-credential"}, kubeconfig.Users[0].User.Exec.Args)
+}
+
+func TestK8SPoolValidateSizes(t *testing.T) {
+	tests := []struct {
+		name       string
+		size       int
+		minSize    int
+		maxSize    int
+		maxSizeSet bool
+		expectErr  bool
+	}{
+		{name: "no max size", size: 1, minSize: 1},
+		{name: "valid boundaries", size: 2, minSize: 1, maxSize: 3, maxSizeSet: true},
+		{name: "valid autoscaling", size: 1, minSize: 1, maxSize: 5, maxSizeSet: true},
+		{name: "min size zero", size: 1, minSize: 0, maxSize: 5, maxSizeSet: true},
+		{name: "min size above size", size: 1, minSize: 2, expectErr: true},
+		{name: "size above max size", size: 4, minSize: 1, maxSize: 3, maxSizeSet: true, expectErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := k8sPoolValidateSizes(tt.size, tt.minSize, tt.maxSize, tt.maxSizeSet)
+			if tt.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestK8SValidateClusterVersion(t *testing.T) {
+	assert.NoError(t, k8sValidateClusterVersion("1.21", true))
+	assert.NoError(t, k8sValidateClusterVersion("1.21", false))
+	assert.NoError(t, k8sValidateClusterVersion("1.21.1", false))
+	assert.Error(t, k8sValidateClusterVersion("1.21.1", true))
+}
+
+func TestK8SClusterTracksLatestPatch(t *testing.T) {
+	assert.True(t, k8sClusterTracksLatestPatch("1.21", false, false))
+	assert.False(t, k8sClusterTracksLatestPatch("1.21", false, true), "pin_patch_version keeps the current patch")
+	assert.False(t, k8sClusterTracksLatestPatch("1.21", true, false), "auto upgrades are done by the API")
+	assert.False(t, k8sClusterTracksLatestPatch("1.21.1", false, false), "a full version is never upgraded")
+}
+
+func TestK8SPoolValidateUpgradePolicy(t *testing.T) {
+	assert.NoError(t, k8sPoolValidateUpgradePolicy(0, 1))
+	assert.NoError(t, k8sPoolValidateUpgradePolicy(1, 0))
+	assert.NoError(t, k8sPoolValidateUpgradePolicy(2, 3))
+	assert.Error(t, k8sPoolValidateUpgradePolicy(0, 0))
+}
+
+func TestValidateKubeletArgs(t *testing.T) {
+	tests := []struct {
+		name      string
+		args      map[string]interface{}
+		expectErr bool
+	}{
+		{name: "empty", args: map[string]interface{}{}},
+		{name: "valid", args: map[string]interface{}{"maxPods": "50", "cpuCFSQuota": "false", "containerLogMaxSize": "10Mi", "cpuManagerPolicy": "static"}},
+		{name: "unknown key", args: map[string]interface{}{"notAnArg": "anything"}},
+		{name: "invalid uint", args: map[string]interface{}{"maxPods": "-1"}, expectErr: true},
+		{name: "invalid bool", args: map[string]interface{}{"cpuCFSQuota": "maybe"}, expectErr: true},
+		{name: "invalid quantity", args: map[string]interface{}{"containerLogMaxSize": "10MB"}, expectErr: true},
+		{name: "invalid duration", args: map[string]interface{}{"cpuCFSQuotaPeriod": "100"}, expectErr: true},
+		{name: "invalid enum", args: map[string]interface{}{"cpuManagerPolicy": "dynamic"}, expectErr: true},
+	}
+
+	path := cty.GetAttrPath("kubelet_args")
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diags := validateKubeletArgs()(tt.args, path)
+			assert.Equal(t, tt.expectErr, diags.HasError())
+		})
+	}
+}
+
+const testK8SKubeconfigFile = `apiVersion: v1
+clusters:
+- name: my-cluster
+  cluster:
+    certificate-authority-data: Y2E=
+    server: https://11111111-1111-1111-1111-111111111111.api.k8s.fr-par.scw.cloud:6443
+contexts:
+- name: admin@my-cluster
+  context:
+    cluster: my-cluster
+    user: my-cluster-admin
+current-context: admin@my-cluster
+kind: Config
+preferences: {}
+users:
+- name: my-cluster-admin
+  user:
+    token: secret-token
+`
+
+const testK8SExistingKubeconfigFile = `apiVersion: v1
+clusters:
+- name: other
+  cluster:
+    server: https://other.example.com:6443
+contexts:
+- name: other
+  context:
+    cluster: other
+    namespace: kube-system
+    user: other
+current-context: other
+kind: Config
+users:
+- name: other
+  user:
+    client-certificate-data: Y2VydA==
+`
+
+func TestK8SKubeconfigRename(t *testing.T) {
+	config, err := k8sKubeconfigRename([]byte(testK8SKubeconfigFile), "prod")
+	require.NoError(t, err)
+
+	currentContext, err := k8sKubeconfigCurrentContext(config)
+	require.NoError(t, err)
+	assert.Equal(t, "prod", currentContext)
+
+	kubeconfig := KubeconfigStruct{}
+	require.NoError(t, yaml.Unmarshal(config, &kubeconfig))
+	assert.Equal(t, "prod", kubeconfig.Clusters[0].Name)
+	assert.Equal(t, "prod", kubeconfig.Contexts[0].Name)
+	assert.Equal(t, "prod", kubeconfig.Contexts[0].Context.Cluster)
+	assert.Equal(t, "prod", kubeconfig.Contexts[0].Context.User)
+	assert.Equal(t, "prod", kubeconfig.Users[0].Name)
+	assert.Equal(t, "secret-token", kubeconfig.Users[0].User.Token)
+}
+
+func TestK8SKubeconfigMergeAndRemove(t *testing.T) {
+	config, err := k8sKubeconfigRename([]byte(testK8SKubeconfigFile), "prod")
+	require.NoError(t, err)
+
+	merged, err := k8sKubeconfigMerge([]byte(testK8SExistingKubeconfigFile), config)
+	require.NoError(t, err)
+
+	for _, name := range []string{"other", "prod"} {
+		hasContext, err := k8sKubeconfigHasContext(merged, name)
+		require.NoError(t, err)
+		assert.True(t, hasContext, name)
+	}
+	currentContext, err := k8sKubeconfigCurrentContext(merged)
+	require.NoError(t, err)
+	assert.Equal(t, "prod", currentContext)
+	assert.Contains(t, string(merged), "client-certificate-data: Y2VydA==")
+	assert.Contains(t, string(merged), "namespace: kube-system")
+
+	// merging again replaces the entries instead of duplicating them
+	mergedTwice, err := k8sKubeconfigMerge(merged, config)
+	require.NoError(t, err)
+	assert.Equal(t, string(merged), string(mergedTwice))
+
+	removed, err := k8sKubeconfigRemove(merged, "prod")
+	require.NoError(t, err)
+
+	hasContext, err := k8sKubeconfigHasContext(removed, "prod")
+	require.NoError(t, err)
+	assert.False(t, hasContext)
+	hasContext, err = k8sKubeconfigHasContext(removed, "other")
+	require.NoError(t, err)
+	assert.True(t, hasContext)
+	currentContext, err = k8sKubeconfigCurrentContext(removed)
+	require.NoError(t, err)
+	assert.Equal(t, "", currentContext)
+}
@@ -1,6 +1,7 @@
 package scaleway
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"net/http"
@@ -13,6 +14,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws/credentials"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/scaleway/scaleway-sdk-go/scw"
 )
@@ -195,3 +197,45 @@ func expandBucketCORS(rawCors []interface{}, bucket string) []*s3.CORSRule {
 	}
 	return rules
 }
+
+func objectBucketUpgradeV1SchemaType() cty.Type {
+	return cty.Object(map[string]cty.Type{
+		"id":     cty.String,
+		"region": cty.String,
+	})
+}
+
+// objectBucketUpgradeV1SchemaUpgradeFunc upgrades legacy states where the bucket id was only the bucket name to a regional id.
+func objectBucketUpgradeV1SchemaUpgradeFunc(_ context.Context, rawState map[string]interface{}, m interface{}) (map[string]interface{}, error) {
+	ID, exist := rawState["id"]
+	if !exist {
+		return nil, fmt.Errorf("upgrade: id not exist")
+	}
+
+	var region scw.Region
+	if rawRegion, ok := rawState["region"].(string); ok && rawRegion != "" {
+		region = scw.Region(rawRegion)
+	} else if meta, ok := m.(*Meta); ok {
+		region, _ = meta.scwClient.GetDefaultRegion()
+	}
+
+	upgradedID, err := objectBucketUpgradeV1NameToRegionalID(ID.(string), region)
+	if err != nil {
+		return nil, err
+	}
+	rawState["id"] = upgradedID
+	rawState["region"] = region.String()
+
+	return rawState, nil
+}
+
+func objectBucketUpgradeV1NameToRegionalID(element string, region scw.Region) (string, error) {
+	// id is already regional
+	if _, _, err := parseRegionalID(element); err == nil {
+		return element, nil
+	}
+	if region == "" {
+		return "", fmt.Errorf("upgrade: could not guess the region of bucket `%s`", element)
+	}
+	return newRegionalIDString(region, element), nil
+}
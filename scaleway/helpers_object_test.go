@@ -48,3 +48,43 @@ func TestExpandObjectBucketTags(t *testing.T) {
 		})
 	}
 }
+
+func TestObjectBucketUpgradeV1NameToRegionalID(t *testing.T) {
+	tests := []struct {
+		name     string
+		id       string
+		region   scw.Region
+		expected string
+		err      bool
+	}{
+		{
+			name:     "bucket name only",
+			id:       "my-bucket",
+			region:   scw.RegionFrPar,
+			expected: "fr-par/my-bucket",
+		},
+		{
+			name:     "already regional",
+			id:       "nl-ams/my-bucket",
+			region:   scw.RegionFrPar,
+			expected: "nl-ams/my-bucket",
+		},
+		{
+			name:   "no region",
+			id:     "my-bucket",
+			region: "",
+			err:    true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			id, err := objectBucketUpgradeV1NameToRegionalID(tt.id, tt.region)
+			if tt.err {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, id)
+		})
+	}
+}
@@ -3,6 +3,7 @@ package scaleway
 import (
 	"context"
 	"reflect"
+	"sync"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -14,6 +15,29 @@ const (
 	defaultRdbInstanceTimeout = 15 * time.Minute
 )
 
+// rdbInstanceLocks serializes the writes made by the provider on the same instance,
+// the API rejects them while the instance is in a transient state.
+var rdbInstanceLocks = &rdbInstanceMutex{locks: map[string]*sync.Mutex{}}
+
+type rdbInstanceMutex struct {
+	mu    sync.Mutex
+	locks map[string]*sync.Mutex
+}
+
+// lock locks the given instance and returns the function unlocking it
+func (m *rdbInstanceMutex) lock(instanceID string) func() {
+	m.mu.Lock()
+	lock, exist := m.locks[instanceID]
+	if !exist {
+		lock = &sync.Mutex{}
+		m.locks[instanceID] = lock
+	}
+	m.mu.Unlock()
+
+	lock.Lock()
+	return lock.Unlock
+}
+
 // newRdbAPI returns a new RDB API
 func newRdbAPI(m interface{}) *rdb.API {
 	meta := m.(*Meta)
@@ -74,13 +98,13 @@ func expandInstanceSettings(i interface{}) []*rdb.InstanceSetting {
 	return res
 }
 
-func waitInstance(ctx context.Context, api *rdb.API, region scw.Region, id string) (*rdb.Instance, error) {
-	retryInterval := defaultWaitRDBRetryInterval
+func waitInstance(ctx context.Context, meta interface{}, api *rdb.API, region scw.Region, id string) (*rdb.Instance, error) {
+	retryInterval := waitRetryInterval(meta, defaultWaitRDBRetryInterval)
 	return api.WaitForInstance(&rdb.WaitForInstanceRequest{
 		Region:        region,
 		InstanceID:    id,
 		Timeout:       scw.TimeDurationPtr(defaultInstanceServerWaitTimeout * 3), // upgrade takes some time
-		RetryInterval: &retryInterval,
+		RetryInterval: retryInterval,
 	}, scw.WithContext(ctx))
 }
 
@@ -152,6 +176,24 @@ func endpointsToRemove(endPoints []*rdb.Endpoint, updates interface{}) (map[stri
 	return actions, nil
 }
 
+// rdbPrivateNetworkEndpointExists returns true when one of the endpoints already exposes the instance with the same IP in the private network
+func rdbPrivateNetworkEndpointExists(endpoints []*rdb.Endpoint, spec *rdb.EndpointSpecPrivateNetwork) bool {
+	specIP, err := flattenIPNet(spec.ServiceIP)
+	if err != nil {
+		return false
+	}
+	for _, endpoint := range endpoints {
+		if endpoint.PrivateNetwork == nil || endpoint.PrivateNetwork.PrivateNetworkID != spec.PrivateNetworkID {
+			continue
+		}
+		endpointIP, err := flattenIPNet(endpoint.PrivateNetwork.ServiceIP)
+		if err == nil && endpointIP == specIP {
+			return true
+		}
+	}
+	return false
+}
+
 func newEndPointPrivateNetworkDetails(id, ip, locality string) (*rdb.EndpointPrivateNetworkDetails, error) {
 	serviceIP, err := expandIPNet(ip)
 	if err != nil {
@@ -2,7 +2,9 @@ package scaleway
 
 import (
 	"net"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/scaleway/scaleway-sdk-go/api/rdb/v1"
 	"github.com/scaleway/scaleway-sdk-go/scw"
@@ -118,3 +120,56 @@ func TestEndpointsToRemove(t *testing.T) {
 		})
 	}
 }
+
+func TestRdbInstanceMutex(t *testing.T) {
+	m := &rdbInstanceMutex{locks: map[string]*sync.Mutex{}}
+
+	unlock := m.lock("instance-1")
+
+	// Another instance is not blocked
+	m.lock("instance-2")()
+
+	locked := make(chan struct{})
+	go func() {
+		defer m.lock("instance-1")()
+		close(locked)
+	}()
+
+	select {
+	case <-locked:
+		t.Fatal("instance-1 should still be locked")
+	case <-time.After(10 * time.Millisecond):
+	}
+
+	unlock()
+	<-locked
+}
+
+func TestRdbPrivateNetworkEndpointExists(t *testing.T) {
+	ipNet := func(raw string) scw.IPNet {
+		ip, err := expandIPNet(raw)
+		assert.NoError(t, err)
+		return ip
+	}
+	endpoints := []*rdb.Endpoint{
+		{ID: "lb"},
+		{ID: "pn", PrivateNetwork: &rdb.EndpointPrivateNetworkDetails{
+			PrivateNetworkID: "6ba7b810-9dad-11d1-80b4-00c04fd430c8",
+			ServiceIP:        ipNet("192.168.1.254/24"),
+			Zone:             scw.ZoneFrPar1,
+		}},
+	}
+
+	assert.True(t, rdbPrivateNetworkEndpointExists(endpoints, &rdb.EndpointSpecPrivateNetwork{
+		PrivateNetworkID: "6ba7b810-9dad-11d1-80b4-00c04fd430c8",
+		ServiceIP:        ipNet("192.168.1.254/24"),
+	}))
+	assert.False(t, rdbPrivateNetworkEndpointExists(endpoints, &rdb.EndpointSpecPrivateNetwork{
+		PrivateNetworkID: "6ba7b810-9dad-11d1-80b4-00c04fd430c8",
+		ServiceIP:        ipNet("192.168.1.253/24"),
+	}))
+	assert.False(t, rdbPrivateNetworkEndpointExists(endpoints, &rdb.EndpointSpecPrivateNetwork{
+		PrivateNetworkID: "11111111-1111-1111-1111-111111111111",
+		ServiceIP:        ipNet("192.168.1.254/24"),
+	}))
+}
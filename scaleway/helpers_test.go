@@ -7,6 +7,7 @@ import (
 	"regexp"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -149,6 +150,22 @@ func TestNewRegionalId(t *testing.T) {
 	assert.Equal(t, "fr-par/my-id", newRegionalIDString(scw.RegionFrPar, "my-id"))
 }
 
+func TestWaitRetryInterval(t *testing.T) {
+	previous := DefaultWaitRetryInterval
+	defer func() { DefaultWaitRetryInterval = previous }()
+
+	DefaultWaitRetryInterval = nil
+	assert.Equal(t, 30*time.Second, *waitRetryInterval(nil, 30*time.Second))
+	assert.Nil(t, waitRetryIntervalOverride(&Meta{}))
+
+	DefaultWaitRetryInterval = scw.TimeDurationPtr(0)
+	assert.Equal(t, time.Duration(0), *waitRetryInterval(nil, 30*time.Second))
+
+	meta := &Meta{waitRetryInterval: scw.TimeDurationPtr(5 * time.Second)}
+	assert.Equal(t, 5*time.Second, *waitRetryInterval(meta, 30*time.Second))
+	assert.Equal(t, 5*time.Second, *waitRetryIntervalOverride(meta))
+}
+
 func TestIsHTTPCodeError(t *testing.T) {
 	assert.True(t, isHTTPCodeError(&scw.ResponseError{StatusCode: http.StatusBadRequest}, http.StatusBadRequest))
 	assert.False(t, isHTTPCodeError(nil, http.StatusBadRequest))
@@ -191,6 +208,25 @@ func testCheckResourceAttrFunc(name string, key string, test func(string) error)
 	}
 }
 
+// testCheckResourceIDPersisted stores the resource ID on the first call and checks that it did not change on the next ones,
+// i.e. that the resource has been updated in place.
+func testCheckResourceIDPersisted(name string, id *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", name)
+		}
+		if *id == "" {
+			*id = rs.Primary.ID
+			return nil
+		}
+		if rs.Primary.ID != *id {
+			return fmt.Errorf("resource %s has been recreated: %s became %s", name, *id, rs.Primary.ID)
+		}
+		return nil
+	}
+}
+
 var UUIDRegex = regexp.MustCompile(`[0-9a-fA-F]{8}\-[0-9a-fA-F]{4}\-[0-9a-fA-F]{4}\-[0-9a-fA-F]{4}\-[0-9a-fA-F]{12}`)
 
 func testCheckResourceAttrUUID(name string, key string) resource.TestCheckFunc {
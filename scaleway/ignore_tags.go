@@ -0,0 +1,72 @@
+package scaleway
+
+import (
+	"strings"
+)
+
+// ignoreTagsConfig describes the tags managed outside of terraform, e.g. by the kubernetes cloud controller manager.
+// Ignored tags are not read into the state and are kept untouched on update.
+type ignoreTagsConfig struct {
+	keys        []string
+	keyPrefixes []string
+}
+
+func expandIgnoreTagsConfig(raw interface{}) *ignoreTagsConfig {
+	rawList, ok := raw.([]interface{})
+	if !ok || len(rawList) == 0 || rawList[0] == nil {
+		return nil
+	}
+	rawMap := rawList[0].(map[string]interface{})
+
+	return &ignoreTagsConfig{
+		keys:        expandStringsOrEmpty(rawMap["keys"]),
+		keyPrefixes: expandStringsOrEmpty(rawMap["key_prefixes"]),
+	}
+}
+
+// isIgnored returns true if the key of the tag, the part before the first `=`, matches the config.
+func (c *ignoreTagsConfig) isIgnored(tag string) bool {
+	if c == nil {
+		return false
+	}
+	key := strings.SplitN(tag, "=", 2)[0]
+	for _, k := range c.keys {
+		if key == k {
+			return true
+		}
+	}
+	for _, prefix := range c.keyPrefixes {
+		if strings.HasPrefix(key, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// filter returns the tags that are not ignored, it should be used before setting tags in the state.
+func (c *ignoreTagsConfig) filter(tags []string) []string {
+	if c == nil {
+		return tags
+	}
+	filtered := []string(nil)
+	for _, tag := range tags {
+		if !c.isIgnored(tag) {
+			filtered = append(filtered, tag)
+		}
+	}
+	return filtered
+}
+
+// merge adds the ignored tags present on the remote resource to the wanted tags, so they are not removed on update.
+func (c *ignoreTagsConfig) merge(wanted []string, remote []string) []string {
+	if c == nil {
+		return wanted
+	}
+	merged := append([]string(nil), wanted...)
+	for _, tag := range remote {
+		if c.isIgnored(tag) {
+			merged = append(merged, tag)
+		}
+	}
+	return merged
+}
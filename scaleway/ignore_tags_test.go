@@ -0,0 +1,28 @@
+package scaleway
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIgnoreTagsConfig(t *testing.T) {
+	c := expandIgnoreTagsConfig([]interface{}{
+		map[string]interface{}{
+			"keys":         []interface{}{"cluster"},
+			"key_prefixes": []interface{}{"kapsule-"},
+		},
+	})
+
+	remote := []string{"web", "cluster=1111", "kapsule-node", "clustered"}
+
+	assert.Equal(t, []string{"web", "clustered"}, c.filter(remote))
+	assert.Equal(t, []string{"front", "cluster=1111", "kapsule-node"}, c.merge([]string{"front"}, remote))
+}
+
+func TestIgnoreTagsConfigNil(t *testing.T) {
+	var c *ignoreTagsConfig
+	assert.Nil(t, expandIgnoreTagsConfig([]interface{}{}))
+	assert.Equal(t, []string{"web"}, c.filter([]string{"web"}))
+	assert.Equal(t, []string{"front"}, c.merge([]string{"front"}, []string{"web"}))
+}
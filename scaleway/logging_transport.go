@@ -0,0 +1,65 @@
+package scaleway
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/logging"
+)
+
+// sensitiveQueryParameters are the query parameters whose values are redacted from logs.
+var sensitiveQueryParameters = []string{"token", "secret", "password", "key", "kubeconfig"}
+
+// newLoggingTransport creates a http transport that logs a summary of each request when TF_LOG is DEBUG or higher.
+// Only the method, sanitized URL, status, duration and request ID are logged, bodies and headers never are.
+func newLoggingTransport(transport http.RoundTripper) http.RoundTripper {
+	return &loggingTransport{transport: transport}
+}
+
+type loggingTransport struct {
+	transport http.RoundTripper
+}
+
+// RoundTrip sends the request and logs its summary.
+func (t *loggingTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	if !logging.IsDebugOrHigher() {
+		return t.transport.RoundTrip(r)
+	}
+
+	start := time.Now()
+	resp, err := t.transport.RoundTrip(r)
+	duration := time.Since(start)
+
+	if err != nil {
+		l.Debugf("HTTP %s %s failed after %s: %s", r.Method, sanitizeURL(r.URL), duration, err)
+		return resp, err
+	}
+	l.Debugf("HTTP %s %s status=%d duration=%s request_id=%s", r.Method, sanitizeURL(r.URL), resp.StatusCode, duration, resp.Header.Get("X-Request-Id"))
+
+	return resp, nil
+}
+
+// sanitizeURL returns the URL with credentials and sensitive query parameter values redacted.
+func sanitizeURL(u *url.URL) string {
+	if u == nil {
+		return ""
+	}
+	sanitized := *u
+	if sanitized.User != nil {
+		sanitized.User = url.User("REDACTED")
+	}
+
+	query := sanitized.Query()
+	for key := range query {
+		for _, sensitive := range sensitiveQueryParameters {
+			if strings.Contains(strings.ToLower(key), sensitive) {
+				query.Set(key, "REDACTED")
+			}
+		}
+	}
+	sanitized.RawQuery = query.Encode()
+
+	return sanitized.String()
+}
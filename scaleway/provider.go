@@ -4,9 +4,12 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/plugin"
 	"github.com/scaleway/scaleway-sdk-go/scw"
 )
@@ -57,6 +60,79 @@ func Provider(config *ProviderConfig) plugin.ProviderFunc {
 					Optional:    true,
 					Description: "The Scaleway API URL to use.",
 				},
+				"fallback_zones": {
+					Type: schema.TypeList,
+					Elem: &schema.Schema{
+						Type:             schema.TypeString,
+						ValidateDiagFunc: validateStringInSliceWithWarning(AllZones(), "fallback_zones"),
+					},
+					Optional:    true,
+					Description: "Ordered list of zones used to create instance servers when the default zone is out of stock.",
+				},
+				"organization_id": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "The Scaleway organization ID the provider is expected to use. Configuration fails if the default organization of the profile is another one.",
+					ValidateFunc: validationUUID(),
+				},
+				"allowed_project_ids": {
+					Type: schema.TypeList,
+					Elem: &schema.Schema{
+						Type:         schema.TypeString,
+						ValidateFunc: validationUUID(),
+					},
+					Optional:    true,
+					Description: "The list of project IDs the provider is allowed to use. Configuration fails if the default project is not part of this list and plans fail for resources in other projects.",
+				},
+				"wait_retry_interval": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "The interval between two status checks when waiting for a resource to be ready (e.g. 5s). Use each product default if unset.",
+					ValidateFunc: validateDuration(),
+				},
+				"ignore_tags": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					MaxItems:    1,
+					Description: "Tags managed outside of terraform that should be ignored by resources",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"keys": {
+								Type:        schema.TypeList,
+								Elem:        &schema.Schema{Type: schema.TypeString},
+								Optional:    true,
+								Description: "Tag keys to ignore, the key of a tag is the part before the first `=`",
+							},
+							"key_prefixes": {
+								Type:        schema.TypeList,
+								Elem:        &schema.Schema{Type: schema.TypeString},
+								Optional:    true,
+								Description: "Tag key prefixes to ignore",
+							},
+						},
+					},
+				},
+				"http_proxy": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "The proxy used for HTTP requests. Defaults to HTTP_PROXY environment variable.",
+				},
+				"https_proxy": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "The proxy used for HTTPS requests. Defaults to HTTPS_PROXY environment variable.",
+				},
+				"no_proxy": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Comma-separated list of hosts that should not go through the proxy. Defaults to NO_PROXY environment variable.",
+				},
+				"max_concurrent_requests": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Description:  "The maximum number of concurrent requests sent to the Scaleway API. No limit if unset.",
+					ValidateFunc: validation.IntAtLeast(0),
+				},
 			},
 
 			ResourcesMap: map[string]*schema.Resource{
@@ -65,13 +141,17 @@ func Provider(config *ProviderConfig) plugin.ProviderFunc {
 				"scaleway_baremetal_server":              resourceScalewayBaremetalServer(),
 				"scaleway_domain_record":                 resourceScalewayDomainRecord(),
 				"scaleway_domain_zone":                   resourceScalewayDomainZone(),
+				"scaleway_instance_image":                resourceScalewayInstanceImage(),
 				"scaleway_instance_ip":                   resourceScalewayInstanceIP(),
+				"scaleway_instance_ip_attachment":        resourceScalewayInstanceIPAttachment(),
 				"scaleway_instance_ip_reverse_dns":       resourceScalewayInstanceIPReverseDNS(),
 				"scaleway_instance_volume":               resourceScalewayInstanceVolume(),
 				"scaleway_instance_security_group":       resourceScalewayInstanceSecurityGroup(),
 				"scaleway_instance_security_group_rules": resourceScalewayInstanceSecurityGroupRules(),
 				"scaleway_instance_server":               resourceScalewayInstanceServer(),
 				"scaleway_instance_snapshot":             resourceScalewayInstanceSnapshot(),
+				"scaleway_instance_snapshot_schedule":    resourceScalewayInstanceSnapshotSchedule(),
+				"scaleway_instance_user_data":            resourceScalewayInstanceUserData(),
 				"scaleway_instance_placement_group":      resourceScalewayInstancePlacementGroup(),
 				"scaleway_instance_private_nic":          resourceScalewayInstancePrivateNIC(),
 				"scaleway_iot_hub":                       resourceScalewayIotHub(),
@@ -79,6 +159,7 @@ func Provider(config *ProviderConfig) plugin.ProviderFunc {
 				"scaleway_iot_route":                     resourceScalewayIotRoute(),
 				"scaleway_iot_network":                   resourceScalewayIotNetwork(),
 				"scaleway_k8s_cluster":                   resourceScalewayK8SCluster(),
+				"scaleway_k8s_kubeconfig_file":           resourceScalewayK8SKubeconfigFile(),
 				"scaleway_k8s_pool":                      resourceScalewayK8SPool(),
 				"scaleway_lb":                            resourceScalewayLb(),
 				"scaleway_lb_ip":                         resourceScalewayLbIP(),
@@ -88,6 +169,7 @@ func Provider(config *ProviderConfig) plugin.ProviderFunc {
 				"scaleway_lb_route":                      resourceScalewayLbRoute(),
 				"scaleway_registry_namespace":            resourceScalewayRegistryNamespace(),
 				"scaleway_rdb_acl":                       resourceScalewayRdbACL(),
+				"scaleway_rdb_acl_rule":                  resourceScalewayRdbACLRule(),
 				"scaleway_rdb_database":                  resourceScalewayRdbDatabase(),
 				"scaleway_rdb_instance":                  resourceScalewayRdbInstance(),
 				"scaleway_rdb_privilege":                 resourceScalewayRdbPrivilege(),
@@ -102,34 +184,62 @@ func Provider(config *ProviderConfig) plugin.ProviderFunc {
 			},
 
 			DataSourcesMap: map[string]*schema.Resource{
-				"scaleway_account_ssh_key":         dataSourceScalewayAccountSSHKey(),
-				"scaleway_baremetal_offer":         dataSourceScalewayBaremetalOffer(),
-				"scaleway_domain_record":           dataSourceScalewayDomainRecord(),
-				"scaleway_domain_zone":             dataSourceScalewayDomainZone(),
-				"scaleway_instance_ip":             dataSourceScalewayInstanceIP(),
-				"scaleway_instance_security_group": dataSourceScalewayInstanceSecurityGroup(),
-				"scaleway_instance_server":         dataSourceScalewayInstanceServer(),
-				"scaleway_instance_image":          dataSourceScalewayInstanceImage(),
-				"scaleway_instance_volume":         dataSourceScalewayInstanceVolume(),
-				"scaleway_k8s_cluster":             dataSourceScalewayK8SCluster(),
-				"scaleway_k8s_pool":                dataSourceScalewayK8SPool(),
-				"scaleway_lb":                      dataSourceScalewayLb(),
-				"scaleway_lb_ip":                   dataSourceScalewayLbIP(),
-				"scaleway_marketplace_image":       dataSourceScalewayMarketplaceImage(),
-				"scaleway_object_bucket":           dataSourceScalewayObjectBucket(),
-				"scaleway_rdb_acl":                 dataSourceScalewayRDBACL(),
-				"scaleway_rdb_instance":            dataSourceScalewayRDBInstance(),
-				"scaleway_rdb_database":            dataSourceScalewayRDBDatabase(),
-				"scaleway_rdb_privilege":           dataSourceScalewayRDBPrivilege(),
-				"scaleway_registry_namespace":      dataSourceScalewayRegistryNamespace(),
-				"scaleway_registry_image":          dataSourceScalewayRegistryImage(),
-				"scaleway_vpc_public_gateway":      dataSourceScalewayVPCPublicGateway(),
-				"scaleway_vpc_public_gateway_dhcp": dataSourceScalewayVPCPublicGatewayDHCP(),
-				"scaleway_vpc_public_gateway_ip":   dataSourceScalewayVPCPublicGatewayIP(),
-				"scaleway_vpc_private_network":     dataSourceScalewayVPCPrivateNetwork(),
+				"scaleway_account_ssh_key":          dataSourceScalewayAccountSSHKey(),
+				"scaleway_baremetal_offer":          dataSourceScalewayBaremetalOffer(),
+				"scaleway_baremetal_os":             dataSourceScalewayBaremetalOs(),
+				"scaleway_config":                   dataSourceScalewayConfig(),
+				"scaleway_domain_record":            dataSourceScalewayDomainRecord(),
+				"scaleway_domain_zone":              dataSourceScalewayDomainZone(),
+				"scaleway_domain_zones":             dataSourceScalewayDomainZones(),
+				"scaleway_function":                 dataSourceScalewayFunction(),
+				"scaleway_instance_ip":              dataSourceScalewayInstanceIP(),
+				"scaleway_instance_placement_group": dataSourceScalewayInstancePlacementGroup(),
+				"scaleway_instance_private_nic":     dataSourceScalewayInstancePrivateNIC(),
+				"scaleway_instance_security_group":  dataSourceScalewayInstanceSecurityGroup(),
+				"scaleway_instance_server":          dataSourceScalewayInstanceServer(),
+				"scaleway_instance_servers":         dataSourceScalewayInstanceServers(),
+				"scaleway_instance_snapshot":        dataSourceScalewayInstanceSnapshot(),
+				"scaleway_instance_image":           dataSourceScalewayInstanceImage(),
+				"scaleway_instance_images":          dataSourceScalewayInstanceImages(),
+				"scaleway_instance_volume":          dataSourceScalewayInstanceVolume(),
+				"scaleway_k8s_cluster":              dataSourceScalewayK8SCluster(),
+				"scaleway_k8s_kubeconfig":           dataSourceScalewayK8SKubeconfig(),
+				"scaleway_k8s_nodes":                dataSourceScalewayK8SNodes(),
+				"scaleway_k8s_pool":                 dataSourceScalewayK8SPool(),
+				"scaleway_lb":                       dataSourceScalewayLb(),
+				"scaleway_lb_ip":                    dataSourceScalewayLbIP(),
+				"scaleway_lb_ips":                   dataSourceScalewayLbIPs(),
+				"scaleway_marketplace_image":        dataSourceScalewayMarketplaceImage(),
+				"scaleway_object_bucket":            dataSourceScalewayObjectBucket(),
+				"scaleway_prices":                   dataSourceScalewayPrices(),
+				"scaleway_rdb_acl":                  dataSourceScalewayRDBACL(),
+				"scaleway_rdb_instance":             dataSourceScalewayRDBInstance(),
+				"scaleway_rdb_database":             dataSourceScalewayRDBDatabase(),
+				"scaleway_rdb_privilege":            dataSourceScalewayRDBPrivilege(),
+				"scaleway_registry_namespace":       dataSourceScalewayRegistryNamespace(),
+				"scaleway_registry_namespaces":      dataSourceScalewayRegistryNamespaces(),
+				"scaleway_registry_image":           dataSourceScalewayRegistryImage(),
+				"scaleway_vpc_public_gateway":       dataSourceScalewayVPCPublicGateway(),
+				"scaleway_vpc_public_gateway_dhcp":  dataSourceScalewayVPCPublicGatewayDHCP(),
+				"scaleway_vpc_public_gateway_ip":    dataSourceScalewayVPCPublicGatewayIP(),
+				"scaleway_vpc_public_gateways":      dataSourceScalewayVPCPublicGateways(),
+				"scaleway_vpc_private_network":      dataSourceScalewayVPCPrivateNetwork(),
 			},
 		}
 
+		// check the project of every resource against the allowed_project_ids of the provider
+		for _, resource := range p.ResourcesMap {
+			if _, hasProjectID := resource.Schema["project_id"]; !hasProjectID {
+				continue
+			}
+			if resource.CustomizeDiff == nil {
+				resource.CustomizeDiff = customizeDiffAllowedProjectID
+			} else {
+				resource.CustomizeDiff = customdiff.All(resource.CustomizeDiff, customizeDiffAllowedProjectID)
+			}
+			resource.CreateContext = createContextAllowedProjectID(resource.CreateContext)
+		}
+
 		p.ConfigureContextFunc = func(ctx context.Context, data *schema.ResourceData) (interface{}, diag.Diagnostics) {
 			terraformVersion := p.TerraformVersion
 
@@ -162,6 +272,14 @@ type Meta struct {
 	// or it can be a http.Client used to record and replay cassettes which is useful
 	// to replay recorded interactions with APIs locally
 	httpClient *http.Client
+	// ignoreTags contains the tags managed outside of terraform.
+	ignoreTags *ignoreTagsConfig
+	// fallbackZones are tried in order when a resource is out of stock in its default zone.
+	fallbackZones []scw.Zone
+	// allowedProjectIDs restricts the projects resources can be managed in, any project is allowed when empty.
+	allowedProjectIDs []string
+	// waitRetryInterval overrides the retry interval of all waiters when set.
+	waitRetryInterval *time.Duration
 }
 
 type MetaConfig struct {
@@ -189,7 +307,10 @@ func buildMeta(config *MetaConfig) (*Meta, error) {
 		profile.DefaultZone = scw.StringPtr(config.forceZone.String())
 	}
 
-	// TODO validated profile
+	err = validateProfileAssumptions(config.providerSchema, profile)
+	if err != nil {
+		return nil, err
+	}
 
 	////
 	// Create scaleway SDK client
@@ -199,7 +320,20 @@ func buildMeta(config *MetaConfig) (*Meta, error) {
 		scw.WithProfile(profile),
 	}
 
-	httpClient := &http.Client{Transport: newRetryableTransport(http.DefaultTransport)}
+	transport := http.DefaultTransport
+	maxConcurrentRequests := 0
+	if config.providerSchema != nil {
+		maxConcurrentRequests = config.providerSchema.Get("max_concurrent_requests").(int)
+
+		httpProxy := config.providerSchema.Get("http_proxy").(string)
+		httpsProxy := config.providerSchema.Get("https_proxy").(string)
+		noProxy := config.providerSchema.Get("no_proxy").(string)
+		if httpProxy != "" || httpsProxy != "" || noProxy != "" {
+			transport = newProxyTransport(httpProxy, httpsProxy, noProxy)
+		}
+	}
+
+	httpClient := &http.Client{Transport: newRetryableTransport(newLoggingTransport(newConcurrencyLimitedTransport(transport, maxConcurrentRequests)))}
 	if config.httpClient != nil {
 		httpClient = config.httpClient
 	}
@@ -210,12 +344,108 @@ func buildMeta(config *MetaConfig) (*Meta, error) {
 		return nil, err
 	}
 
+	var ignoreTags *ignoreTagsConfig
+	var fallbackZones []scw.Zone
+	var allowedProjectIDs []string
+	var waitRetryInterval *time.Duration
+	if config.providerSchema != nil {
+		ignoreTags = expandIgnoreTagsConfig(config.providerSchema.Get("ignore_tags"))
+		allowedProjectIDs = expandStrings(config.providerSchema.Get("allowed_project_ids"))
+		for _, rawZone := range expandStrings(config.providerSchema.Get("fallback_zones")) {
+			zone, err := scw.ParseZone(rawZone)
+			if err != nil {
+				return nil, err
+			}
+			fallbackZones = append(fallbackZones, zone)
+		}
+
+		if rawInterval, exist := config.providerSchema.GetOk("wait_retry_interval"); exist {
+			waitRetryInterval, err = expandDuration(rawInterval)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
 	return &Meta{
-		scwClient:  scwClient,
-		httpClient: httpClient,
+		scwClient:         scwClient,
+		httpClient:        httpClient,
+		ignoreTags:        ignoreTags,
+		fallbackZones:     fallbackZones,
+		allowedProjectIDs: allowedProjectIDs,
+		waitRetryInterval: waitRetryInterval,
 	}, nil
 }
 
+// validateProfileAssumptions checks that the resolved profile matches the organization and projects expected in the provider configuration.
+func validateProfileAssumptions(d *schema.ResourceData, profile *scw.Profile) error {
+	if d == nil {
+		return nil
+	}
+
+	if organizationID, exist := d.GetOk("organization_id"); exist {
+		if profile.DefaultOrganizationID == nil || *profile.DefaultOrganizationID == "" {
+			return fmt.Errorf("organization_id is set to %s but no organization could be resolved from the credentials, please set SCW_DEFAULT_ORGANIZATION_ID or default_organization_id in your profile", organizationID)
+		}
+		if *profile.DefaultOrganizationID != organizationID.(string) {
+			return fmt.Errorf("the default organization of the profile is %s, expected %s", *profile.DefaultOrganizationID, organizationID)
+		}
+	}
+
+	if allowedProjectIDs, exist := d.GetOk("allowed_project_ids"); exist {
+		if profile.DefaultProjectID == nil || *profile.DefaultProjectID == "" {
+			return fmt.Errorf("allowed_project_ids is set but no default project could be resolved")
+		}
+		return validateAllowedProjectID(expandStrings(allowedProjectIDs), *profile.DefaultProjectID)
+	}
+
+	return nil
+}
+
+// validateAllowedProjectID returns an error if the project is not part of the allowed projects.
+func validateAllowedProjectID(allowedProjectIDs []string, projectID string) error {
+	for _, allowedProjectID := range allowedProjectIDs {
+		if allowedProjectID == projectID {
+			return nil
+		}
+	}
+	return fmt.Errorf("project %s is not part of allowed_project_ids", projectID)
+}
+
+// customizeDiffAllowedProjectID fails the plan of a resource whose project_id is not part of the provider allowed_project_ids.
+// An empty project_id is the default project of the provider, which is checked when the provider is configured.
+// A project_id unknown at plan time is checked at creation by createContextAllowedProjectID.
+func customizeDiffAllowedProjectID(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	m, ok := meta.(*Meta)
+	if !ok || len(m.allowedProjectIDs) == 0 || !diff.NewValueKnown("project_id") {
+		return nil
+	}
+
+	projectID := diff.Get("project_id").(string)
+	if projectID == "" {
+		return nil
+	}
+
+	return validateAllowedProjectID(m.allowedProjectIDs, projectID)
+}
+
+// createContextAllowedProjectID checks the project_id of a resource against the provider allowed_project_ids before creating it.
+func createContextAllowedProjectID(create schema.CreateContextFunc) schema.CreateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		m, ok := meta.(*Meta)
+		if ok && len(m.allowedProjectIDs) > 0 {
+			if projectID := d.Get("project_id").(string); projectID != "" {
+				err := validateAllowedProjectID(m.allowedProjectIDs, projectID)
+				if err != nil {
+					return diag.FromErr(err)
+				}
+			}
+		}
+
+		return create(ctx, d, meta)
+	}
+}
+
 func loadProfile(d *schema.ResourceData) (*scw.Profile, error) {
 	config, err := scw.LoadConfig()
 	// If the config file do not exist, don't return an error as we may find config in ENV or flags.
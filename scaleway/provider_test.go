@@ -13,7 +13,10 @@ import (
 
 	"github.com/dnaeon/go-vcr/cassette"
 	"github.com/dnaeon/go-vcr/recorder"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/scaleway/scaleway-sdk-go/scw"
 	"github.com/scaleway/scaleway-sdk-go/strcase"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -113,3 +116,126 @@ func NewTestTools(t *testing.T) *TestTools {
 		ctx:     context.Background(),
 	}
 }
+
+func TestValidateProfileAssumptions(t *testing.T) {
+	organizationID := "11111111-1111-1111-1111-111111111111"
+	projectID := "22222222-2222-2222-2222-222222222222"
+	otherID := "33333333-3333-3333-3333-333333333333"
+
+	tests := []struct {
+		name    string
+		raw     map[string]interface{}
+		profile *scw.Profile
+		err     bool
+	}{
+		{
+			name:    "no assumptions",
+			raw:     map[string]interface{}{},
+			profile: &scw.Profile{},
+		},
+		{
+			name:    "matching organization",
+			raw:     map[string]interface{}{"organization_id": organizationID},
+			profile: &scw.Profile{DefaultOrganizationID: scw.StringPtr(organizationID)},
+		},
+		{
+			name:    "other organization",
+			raw:     map[string]interface{}{"organization_id": organizationID},
+			profile: &scw.Profile{DefaultOrganizationID: scw.StringPtr(otherID)},
+			err:     true,
+		},
+		{
+			name:    "unknown organization",
+			raw:     map[string]interface{}{"organization_id": organizationID},
+			profile: &scw.Profile{},
+			err:     true,
+		},
+		{
+			name:    "allowed project",
+			raw:     map[string]interface{}{"allowed_project_ids": []interface{}{otherID, projectID}},
+			profile: &scw.Profile{DefaultProjectID: scw.StringPtr(projectID)},
+		},
+		{
+			name:    "forbidden project",
+			raw:     map[string]interface{}{"allowed_project_ids": []interface{}{otherID}},
+			profile: &scw.Profile{DefaultProjectID: scw.StringPtr(projectID)},
+			err:     true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, Provider(DefaultProviderConfig())().Schema, tt.raw)
+			err := validateProfileAssumptions(d, tt.profile)
+			if tt.err {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestCustomizeDiffAllowedProjectID(t *testing.T) {
+	projectID := "22222222-2222-2222-2222-222222222222"
+	otherID := "33333333-3333-3333-3333-333333333333"
+	ipResource := Provider(DefaultProviderConfig())().ResourcesMap["scaleway_instance_ip"]
+
+	tests := []struct {
+		name string
+		raw  map[string]interface{}
+		meta *Meta
+		err  bool
+	}{
+		{
+			name: "no allowed projects",
+			raw:  map[string]interface{}{"project_id": otherID},
+			meta: &Meta{},
+		},
+		{
+			name: "allowed project",
+			raw:  map[string]interface{}{"project_id": projectID},
+			meta: &Meta{allowedProjectIDs: []string{projectID}},
+		},
+		{
+			name: "default project",
+			raw:  map[string]interface{}{},
+			meta: &Meta{allowedProjectIDs: []string{projectID}},
+		},
+		{
+			name: "forbidden project",
+			raw:  map[string]interface{}{"project_id": otherID},
+			meta: &Meta{allowedProjectIDs: []string{projectID}},
+			err:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ipResource.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(tt.raw), tt.meta)
+			if tt.err {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestCreateContextAllowedProjectID(t *testing.T) {
+	projectID := "22222222-2222-2222-2222-222222222222"
+	otherID := "33333333-3333-3333-3333-333333333333"
+	meta := &Meta{allowedProjectIDs: []string{projectID}}
+	created := false
+	create := createContextAllowedProjectID(func(_ context.Context, _ *schema.ResourceData, _ interface{}) diag.Diagnostics {
+		created = true
+		return nil
+	})
+	ipSchema := resourceScalewayInstanceIP().Schema
+
+	diags := create(context.Background(), schema.TestResourceDataRaw(t, ipSchema, map[string]interface{}{"project_id": otherID}), meta)
+	assert.True(t, diags.HasError())
+	assert.False(t, created)
+
+	diags = create(context.Background(), schema.TestResourceDataRaw(t, ipSchema, map[string]interface{}{"project_id": projectID}), meta)
+	assert.False(t, diags.HasError())
+	assert.True(t, created)
+}
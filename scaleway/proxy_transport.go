@@ -0,0 +1,31 @@
+package scaleway
+
+import (
+	"net/http"
+	"net/url"
+
+	"golang.org/x/net/http/httpproxy"
+)
+
+// newProxyTransport creates a http transport using the given proxies.
+// Proxies that are not set fall back on HTTP_PROXY, HTTPS_PROXY and NO_PROXY environment variables.
+func newProxyTransport(httpProxy, httpsProxy, noProxy string) http.RoundTripper {
+	config := httpproxy.FromEnvironment()
+	if httpProxy != "" {
+		config.HTTPProxy = httpProxy
+	}
+	if httpsProxy != "" {
+		config.HTTPSProxy = httpsProxy
+	}
+	if noProxy != "" {
+		config.NoProxy = noProxy
+	}
+
+	proxyFunc := config.ProxyFunc()
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = func(r *http.Request) (*url.URL, error) {
+		return proxyFunc(r.URL)
+	}
+
+	return transport
+}
@@ -0,0 +1,48 @@
+package scaleway
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewProxyTransport(t *testing.T) {
+	transport := newProxyTransport("http://proxy.example.com:3128", "http://secure-proxy.example.com:3128", "s3.fr-par.scw.cloud").(*http.Transport)
+
+	tests := []struct {
+		name     string
+		url      string
+		expected string
+	}{
+		{
+			name:     "https",
+			url:      "https://api.scaleway.com/instance/v1/zones/fr-par-1/servers",
+			expected: "http://secure-proxy.example.com:3128",
+		},
+		{
+			name:     "http",
+			url:      "http://api.scaleway.com/instance/v1/zones/fr-par-1/servers",
+			expected: "http://proxy.example.com:3128",
+		},
+		{
+			name:     "no proxy",
+			url:      "https://s3.fr-par.scw.cloud/bucket",
+			expected: "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, err := http.NewRequest(http.MethodGet, tt.url, nil)
+			require.NoError(t, err)
+			proxyURL, err := transport.Proxy(req)
+			require.NoError(t, err)
+			if tt.expected == "" {
+				assert.Nil(t, proxyURL)
+			} else {
+				assert.Equal(t, tt.expected, proxyURL.String())
+			}
+		})
+	}
+}
@@ -156,7 +156,7 @@ func resourceScalewayBaremetalServerCreate(ctx context.Context, d *schema.Resour
 		Zone:          server.Zone,
 		ServerID:      server.ID,
 		Timeout:       scw.TimeDurationPtr(baremetalServerWaitForTimeout),
-		RetryInterval: DefaultWaitRetryInterval,
+		RetryInterval: waitRetryIntervalOverride(meta),
 	})
 	if err != nil {
 		return diag.FromErr(err)
@@ -177,7 +177,7 @@ func resourceScalewayBaremetalServerCreate(ctx context.Context, d *schema.Resour
 		Zone:          server.Zone,
 		ServerID:      server.ID,
 		Timeout:       scw.TimeDurationPtr(baremetalServerWaitForTimeout),
-		RetryInterval: DefaultWaitRetryInterval,
+		RetryInterval: waitRetryIntervalOverride(meta),
 	})
 	if err != nil {
 		return diag.FromErr(err)
@@ -264,7 +264,7 @@ func resourceScalewayBaremetalServerUpdate(ctx context.Context, d *schema.Resour
 			Zone:          server.Zone,
 			ServerID:      server.ID,
 			Timeout:       scw.TimeDurationPtr(baremetalServerWaitForTimeout),
-			RetryInterval: DefaultWaitRetryInterval,
+			RetryInterval: waitRetryIntervalOverride(meta),
 		})
 		if err != nil {
 			return diag.FromErr(err)
@@ -296,7 +296,7 @@ func resourceScalewayBaremetalServerDelete(ctx context.Context, d *schema.Resour
 		Zone:          server.Zone,
 		ServerID:      server.ID,
 		Timeout:       scw.TimeDurationPtr(baremetalServerWaitForTimeout),
-		RetryInterval: DefaultWaitRetryInterval,
+		RetryInterval: waitRetryIntervalOverride(meta),
 	})
 
 	if err != nil && !is404Error(err) {
@@ -0,0 +1,214 @@
+package scaleway
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/scaleway/scaleway-sdk-go/api/instance/v1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+)
+
+func resourceScalewayInstanceImage() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceScalewayInstanceImageCreate,
+		ReadContext:   resourceScalewayInstanceImageRead,
+		DeleteContext: resourceScalewayInstanceImageDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create:  schema.DefaultTimeout(defaultInstanceImageTimeout),
+			Delete:  schema.DefaultTimeout(defaultInstanceImageTimeout),
+			Default: schema.DefaultTimeout(defaultInstanceImageTimeout),
+		},
+		SchemaVersion: 0,
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The name of the image",
+			},
+			"root_volume_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "ID of the snapshot used as root volume of the image",
+				ValidateFunc: validationUUIDorUUIDWithLocality(),
+			},
+			"additional_volume_ids": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validationUUIDorUUIDWithLocality(),
+				},
+				Description: "IDs of the snapshots used as additional volumes of the image",
+			},
+			"architecture": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  instance.ArchX86_64.String(),
+				ValidateFunc: validation.StringInSlice([]string{
+					instance.ArchX86_64.String(),
+					instance.ArchArm.String(),
+				}, false),
+				Description: "Architecture of the image",
+			},
+			"public": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     false,
+				Description: "Whether the image is public",
+			},
+			"from_server_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "ID of the server the image is based on",
+			},
+			"state": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The state of the image",
+			},
+			"creation_date": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date and time of the creation of the image",
+			},
+			"modification_date": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date and time of the last modification of the image",
+			},
+			"zone":            zoneSchema(),
+			"organization_id": organizationIDSchema(),
+			"project_id":      projectIDSchema(),
+		},
+	}
+}
+
+func resourceScalewayInstanceImageCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceAPI, zone, err := instanceAPIWithZone(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	req := &instance.CreateImageRequest{
+		Zone:       zone,
+		Project:    expandStringPtr(d.Get("project_id")),
+		Name:       expandOrGenerateString(d.Get("name"), "image"),
+		RootVolume: expandZonedID(d.Get("root_volume_id").(string)).ID,
+		Arch:       instance.Arch(d.Get("architecture").(string)),
+		Public:     d.Get("public").(bool),
+	}
+
+	if rawVolumeIDs, ok := d.GetOk("additional_volume_ids"); ok {
+		req.ExtraVolumes = make(map[string]*instance.VolumeTemplate)
+		for i, volumeID := range rawVolumeIDs.([]interface{}) {
+			// The root volume is at index 0, additional volumes start at 1.
+			req.ExtraVolumes[fmt.Sprint(i+1)] = &instance.VolumeTemplate{
+				ID: expandZonedID(volumeID).ID,
+			}
+		}
+	}
+
+	res, err := instanceAPI.CreateImage(req, scw.WithContext(ctx))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(newZonedIDString(zone, res.Image.ID))
+
+	_, err = instanceAPI.WaitForImage(&instance.WaitForImageRequest{
+		ImageID:       res.Image.ID,
+		Zone:          zone,
+		Timeout:       scw.TimeDurationPtr(d.Timeout(schema.TimeoutCreate)),
+		RetryInterval: waitRetryIntervalOverride(meta),
+	}, scw.WithContext(ctx))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceScalewayInstanceImageRead(ctx, d, meta)
+}
+
+func resourceScalewayInstanceImageRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceAPI, zone, id, err := instanceAPIWithZoneAndID(meta, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	res, err := instanceAPI.GetImage(&instance.GetImageRequest{
+		ImageID: id,
+		Zone:    zone,
+	}, scw.WithContext(ctx))
+	if err != nil {
+		if is404Error(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	image := res.Image
+	_ = d.Set("name", image.Name)
+	_ = d.Set("architecture", image.Arch.String())
+	_ = d.Set("public", image.Public)
+	_ = d.Set("from_server_id", image.FromServer)
+	_ = d.Set("state", image.State.String())
+	_ = d.Set("creation_date", flattenTime(image.CreationDate))
+	_ = d.Set("modification_date", flattenTime(image.ModificationDate))
+	_ = d.Set("zone", string(zone))
+	_ = d.Set("organization_id", image.Organization)
+	_ = d.Set("project_id", image.Project)
+
+	if image.RootVolume != nil {
+		_ = d.Set("root_volume_id", newZonedIDString(zone, image.RootVolume.ID))
+	}
+
+	additionalVolumeIDs := []string(nil)
+	for _, volume := range orderVolumes(image.ExtraVolumes) {
+		additionalVolumeIDs = append(additionalVolumeIDs, newZonedIDString(zone, volume.ID))
+	}
+	_ = d.Set("additional_volume_ids", additionalVolumeIDs)
+
+	return nil
+}
+
+func resourceScalewayInstanceImageDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceAPI, zone, id, err := instanceAPIWithZoneAndID(meta, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_, err = instanceAPI.WaitForImage(&instance.WaitForImageRequest{
+		ImageID:       id,
+		Zone:          zone,
+		Timeout:       scw.TimeDurationPtr(d.Timeout(schema.TimeoutDelete)),
+		RetryInterval: waitRetryIntervalOverride(meta),
+	}, scw.WithContext(ctx))
+	if err != nil {
+		if is404Error(err) {
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	err = instanceAPI.DeleteImage(&instance.DeleteImageRequest{
+		ImageID: id,
+		Zone:    zone,
+	}, scw.WithContext(ctx))
+	if err != nil && !is404Error(err) {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
@@ -13,6 +13,7 @@ func resourceScalewayInstanceIP() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceScalewayInstanceIPCreate,
 		ReadContext:   resourceScalewayInstanceIPRead,
+		UpdateContext: resourceScalewayInstanceIPUpdate,
 		DeleteContext: resourceScalewayInstanceIPDelete,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
@@ -29,6 +30,7 @@ func resourceScalewayInstanceIP() *schema.Resource {
 			},
 			"reverse": {
 				Type:        schema.TypeString,
+				Optional:    true,
 				Computed:    true,
 				Description: "The reverse DNS for this IP",
 			},
@@ -59,6 +61,18 @@ func resourceScalewayInstanceIPCreate(ctx context.Context, d *schema.ResourceDat
 	}
 
 	d.SetId(newZonedIDString(zone, res.IP.ID))
+
+	if reverse, ok := d.GetOk("reverse"); ok {
+		_, err = instanceAPI.UpdateIP(&instance.UpdateIPRequest{
+			Zone:    zone,
+			IP:      res.IP.ID,
+			Reverse: &instance.NullableStringValue{Value: reverse.(string)},
+		}, scw.WithContext(ctx))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return resourceScalewayInstanceIPRead(ctx, d, meta)
 }
 
@@ -97,6 +111,33 @@ func resourceScalewayInstanceIPRead(ctx context.Context, d *schema.ResourceData,
 	return nil
 }
 
+func resourceScalewayInstanceIPUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceAPI, zone, ID, err := instanceAPIWithZoneAndID(meta, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChange("reverse") {
+		updateReverseReq := &instance.UpdateIPRequest{
+			Zone: zone,
+			IP:   ID,
+		}
+
+		reverse := d.Get("reverse").(string)
+		if reverse == "" {
+			updateReverseReq.Reverse = &instance.NullableStringValue{Null: true}
+		} else {
+			updateReverseReq.Reverse = &instance.NullableStringValue{Value: reverse}
+		}
+		_, err = instanceAPI.UpdateIP(updateReverseReq, scw.WithContext(ctx))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceScalewayInstanceIPRead(ctx, d, meta)
+}
+
 func resourceScalewayInstanceIPDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	instanceAPI, zone, ID, err := instanceAPIWithZoneAndID(meta, d.Id())
 	if err != nil {
@@ -0,0 +1,184 @@
+package scaleway
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/scaleway/scaleway-sdk-go/api/instance/v1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+)
+
+func resourceScalewayInstanceIPAttachment() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceScalewayInstanceIPAttachmentCreate,
+		ReadContext:   resourceScalewayInstanceIPAttachmentRead,
+		UpdateContext: resourceScalewayInstanceIPAttachmentUpdate,
+		DeleteContext: resourceScalewayInstanceIPAttachmentDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Default: schema.DefaultTimeout(defaultInstanceServerWaitTimeout),
+		},
+		SchemaVersion: 0,
+		Schema: map[string]*schema.Schema{
+			"ip_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				Description:      "The ID of the reserved IP",
+				ValidateFunc:     validationUUIDorUUIDWithLocality(),
+				DiffSuppressFunc: diffSuppressFuncLocality,
+			},
+			"server_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				Description:      "The ID of the server the IP is attached to",
+				ValidateFunc:     validationUUIDorUUIDWithLocality(),
+				DiffSuppressFunc: diffSuppressFuncLocality,
+			},
+			"zone": zoneSchema(),
+		},
+	}
+}
+
+func resourceScalewayInstanceIPAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceAPI, zone, err := instanceAPIWithZone(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	ipID := expandZonedID(d.Get("ip_id")).ID
+	err = attachInstanceIP(ctx, meta, instanceAPI, zone, ipID, expandZonedID(d.Get("server_id")).ID, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(newZonedIDString(zone, ipID))
+
+	return resourceScalewayInstanceIPAttachmentRead(ctx, d, meta)
+}
+
+func resourceScalewayInstanceIPAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceAPI, zone, ID, err := instanceAPIWithZoneAndID(meta, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	res, err := instanceAPI.GetIP(&instance.GetIPRequest{
+		IP:   ID,
+		Zone: zone,
+	}, scw.WithContext(ctx))
+	if err != nil {
+		// We check for 403 because instance API returns 403 for a deleted IP
+		if is404Error(err) || is403Error(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	// The IP has been detached outside of terraform
+	if res.IP.Server == nil {
+		d.SetId("")
+		return nil
+	}
+
+	_ = d.Set("ip_id", newZonedIDString(zone, res.IP.ID))
+	_ = d.Set("server_id", newZonedIDString(zone, res.IP.Server.ID))
+	_ = d.Set("zone", string(zone))
+
+	return nil
+}
+
+func resourceScalewayInstanceIPAttachmentUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceAPI, zone, ID, err := instanceAPIWithZoneAndID(meta, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// The IP is moved to the new server without being detached first
+	if d.HasChange("server_id") {
+		err = attachInstanceIP(ctx, meta, instanceAPI, zone, ID, expandZonedID(d.Get("server_id")).ID, d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceScalewayInstanceIPAttachmentRead(ctx, d, meta)
+}
+
+func resourceScalewayInstanceIPAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceAPI, zone, ID, err := instanceAPIWithZoneAndID(meta, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	serverID := expandZonedID(d.Get("server_id")).ID
+	_, err = instanceAPI.WaitForServer(&instance.WaitForServerRequest{
+		Zone:          zone,
+		ServerID:      serverID,
+		Timeout:       scw.TimeDurationPtr(d.Timeout(schema.TimeoutDelete)),
+		RetryInterval: waitRetryInterval(meta, retryInstanceServerInterval),
+	}, scw.WithContext(ctx))
+	if err != nil {
+		// The IP is detached when its server is deleted
+		if is404Error(err) {
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	_, err = instanceAPI.UpdateIP(&instance.UpdateIPRequest{
+		Zone:   zone,
+		IP:     ID,
+		Server: &instance.NullableStringValue{Null: true},
+	}, scw.WithContext(ctx))
+	if err != nil && !is404Error(err) && !is403Error(err) {
+		return diag.FromErr(err)
+	}
+
+	_, err = instanceAPI.WaitForServer(&instance.WaitForServerRequest{
+		Zone:          zone,
+		ServerID:      serverID,
+		Timeout:       scw.TimeDurationPtr(d.Timeout(schema.TimeoutDelete)),
+		RetryInterval: waitRetryInterval(meta, retryInstanceServerInterval),
+	}, scw.WithContext(ctx))
+	if err != nil && !is404Error(err) {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// attachInstanceIP attaches a reserved IP to a server once the server is in a stable state
+func attachInstanceIP(ctx context.Context, meta interface{}, instanceAPI *instance.API, zone scw.Zone, ipID, serverID string, timeout time.Duration) error {
+	_, err := instanceAPI.WaitForServer(&instance.WaitForServerRequest{
+		Zone:          zone,
+		ServerID:      serverID,
+		Timeout:       scw.TimeDurationPtr(timeout),
+		RetryInterval: waitRetryInterval(meta, retryInstanceServerInterval),
+	}, scw.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+
+	_, err = instanceAPI.UpdateIP(&instance.UpdateIPRequest{
+		Zone:   zone,
+		IP:     ipID,
+		Server: &instance.NullableStringValue{Value: serverID},
+	}, scw.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+
+	_, err = instanceAPI.WaitForServer(&instance.WaitForServerRequest{
+		Zone:          zone,
+		ServerID:      serverID,
+		Timeout:       scw.TimeDurationPtr(timeout),
+		RetryInterval: waitRetryInterval(meta, retryInstanceServerInterval),
+	}, scw.WithContext(ctx))
+	return err
+}
@@ -19,6 +19,8 @@ func resourceScalewayInstanceIPReverseDNS() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Timeouts: &schema.ResourceTimeout{
+			Create:  schema.DefaultTimeout(defaultInstanceIPReverseDNSTimeout),
+			Update:  schema.DefaultTimeout(defaultInstanceIPReverseDNSTimeout),
 			Default: schema.DefaultTimeout(defaultInstanceIPTimeout),
 		},
 		SchemaVersion: 0,
@@ -33,6 +35,12 @@ func resourceScalewayInstanceIPReverseDNS() *schema.Resource {
 				Required:    true,
 				Description: "The reverse DNS for this IP",
 			},
+			"wait_for_resolution": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Wait for the reverse DNS name to resolve to the IP before setting it",
+			},
 			"zone": zoneSchema(),
 		},
 	}
@@ -100,6 +108,26 @@ func resourceScalewayInstanceIPReverseDNSUpdate(ctx context.Context, d *schema.R
 		if reverse == "" {
 			updateReverseReq.Reverse = &instance.NullableStringValue{Null: true}
 		} else {
+			// The API rejects a reverse that does not resolve to the IP yet, the record may still be propagating.
+			if d.Get("wait_for_resolution").(bool) {
+				res, err := instanceAPI.GetIP(&instance.GetIPRequest{
+					IP:   ID,
+					Zone: zone,
+				}, scw.WithContext(ctx))
+				if err != nil {
+					return diag.FromErr(err)
+				}
+
+				timeout := d.Timeout(schema.TimeoutUpdate)
+				if d.IsNewResource() {
+					timeout = d.Timeout(schema.TimeoutCreate)
+				}
+				err = waitInstanceIPReverseDNSResolves(ctx, reverse, res.IP.Address, timeout)
+				if err != nil {
+					return diag.FromErr(err)
+				}
+			}
+
 			updateReverseReq.Reverse = &instance.NullableStringValue{Value: reverse}
 		}
 		_, err = instanceAPI.UpdateIP(updateReverseReq, scw.WithContext(ctx))
@@ -43,7 +43,7 @@ func resourceScalewayInstancePlacementGroup() *schema.Resource {
 			"policy_mode": {
 				Type:        schema.TypeString,
 				Optional:    true,
-				Default:     instance.PlacementGroupPolicyModeOptional,
+				Default:     instance.PlacementGroupPolicyModeOptional.String(),
 				Description: "One of the two policy_mode may be selected: enforced or optional.",
 				ValidateFunc: validation.StringInSlice([]string{
 					instance.PlacementGroupPolicyModeOptional.String(),
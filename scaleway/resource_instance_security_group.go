@@ -3,9 +3,12 @@ package scaleway
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"sort"
+	"strconv"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/scaleway/scaleway-sdk-go/api/instance/v1"
@@ -18,6 +21,10 @@ func resourceScalewayInstanceSecurityGroup() *schema.Resource {
 		ReadContext:   resourceScalewayInstanceSecurityGroupRead,
 		UpdateContext: resourceScalewayInstanceSecurityGroupUpdate,
 		DeleteContext: resourceScalewayInstanceSecurityGroupDelete,
+		CustomizeDiff: customdiff.All(
+			customizeDiffInstanceSecurityGroupRulesPorts,
+			customizeDiffInstanceSecurityGroupSSH,
+		),
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -269,14 +276,36 @@ func resourceScalewayInstanceSecurityGroupUpdate(ctx context.Context, d *schema.
 		return diag.FromErr(err)
 	}
 
+	var warnings diag.Diagnostics
+
 	if !d.Get("external_rules").(bool) {
 		err = updateSecurityGroupeRules(ctx, d, zone, ID, instanceAPI)
 		if err != nil {
 			return diag.FromErr(err)
 		}
+
+		if warning := securityGroupSSHLockoutWarning(d.Get("stateful").(bool), d.Get("inbound_default_policy").(string), d.Get("outbound_default_policy").(string), d.Get("inbound_rule").([]interface{}), d.Get("outbound_rule").([]interface{})); warning != "" {
+			warnings = append(warnings, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  warning,
+			})
+		}
+	}
+
+	return append(warnings, resourceScalewayInstanceSecurityGroupRead(ctx, d, meta)...)
+}
+
+// customizeDiffInstanceSecurityGroupSSH logs a warning at plan time when the default policies would drop SSH traffic.
+// The plugin SDK does not allow a diff to return warnings, the apply returns them as diagnostics.
+func customizeDiffInstanceSecurityGroupSSH(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	if diff.Get("external_rules").(bool) {
+		return nil
 	}
 
-	return resourceScalewayInstanceSecurityGroupRead(ctx, d, meta)
+	if warning := securityGroupSSHLockoutWarning(diff.Get("stateful").(bool), diff.Get("inbound_default_policy").(string), diff.Get("outbound_default_policy").(string), diff.Get("inbound_rule").([]interface{}), diff.Get("outbound_rule").([]interface{})); warning != "" {
+		l.Warningf("security group %s: %s", diff.Get("name"), warning)
+	}
+	return nil
 }
 
 // updateSecurityGroupeRules handles updating SecurityGroupRules
@@ -436,14 +465,16 @@ func securityGroupRuleSchema() *schema.Resource {
 				Description: "Protocol for this rule (TCP, UDP, ICMP or ANY)",
 			},
 			"port": {
-				Type:        schema.TypeInt,
-				Optional:    true,
-				Description: "Network port for this rule",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Description:  "Network port for this rule",
+				ValidateFunc: validation.IntBetween(0, 65535),
 			},
 			"port_range": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "Computed port range for this rule (e.g: 1-1024, 22-22)",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Computed port range for this rule (e.g: 1-1024, 22-22)",
+				ValidateFunc: validateSecurityGroupRulePortRange(),
 			},
 			"ip": {
 				Type:         schema.TypeString,
@@ -461,6 +492,56 @@ func securityGroupRuleSchema() *schema.Resource {
 	}
 }
 
+var securityGroupRulePortRangeRegexp = regexp.MustCompile(`^(\d+)-(\d+)$`)
+
+// validateSecurityGroupRulePortRange checks that a port range is formatted as from-to, with from <= to <= 65535 and a power of 2 size.
+func validateSecurityGroupRulePortRange() schema.SchemaValidateFunc {
+	return func(i interface{}, key string) ([]string, []error) {
+		portRange, isStr := i.(string)
+		if !isStr {
+			return nil, []error{fmt.Errorf("expected type of %s to be string", key)}
+		}
+
+		matches := securityGroupRulePortRangeRegexp.FindStringSubmatch(portRange)
+		if matches == nil {
+			return nil, []error{fmt.Errorf("expected %s to be formatted as from-to (e.g: 1-1024), got %q", key, portRange)}
+		}
+
+		portFrom, errFrom := strconv.Atoi(matches[1])
+		portTo, errTo := strconv.Atoi(matches[2])
+		if errFrom != nil || errTo != nil || portFrom > 65535 || portTo > 65535 {
+			return nil, []error{fmt.Errorf("expected the ports of %s to be in the range (0 - 65535), got %q", key, portRange)}
+		}
+		if portFrom > portTo {
+			return nil, []error{fmt.Errorf("expected the first port of %s to be lower than the last one, got %q", key, portRange)}
+		}
+		// The API only accepts ranges of 2^X ports
+		if size := portTo - portFrom + 1; size&(size-1) != 0 {
+			return nil, []error{fmt.Errorf("expected %s to contain a power of 2 number of ports (e.g: 10000-18191), got %q", key, portRange)}
+		}
+
+		return nil, nil
+	}
+}
+
+// customizeDiffInstanceSecurityGroupRulesPorts checks that only one of port and port_range is set on each rule.
+func customizeDiffInstanceSecurityGroupRulesPorts(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	for _, key := range []string{"inbound_rule", "outbound_rule"} {
+		for i, rawRule := range diff.Get(key).([]interface{}) {
+			rule, ok := rawRule.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			port, _ := rule["port"].(int)
+			portRange, _ := rule["port_range"].(string)
+			if port != 0 && portRange != "" {
+				return fmt.Errorf("%s.%d: only one of port and port_range should be set", key, i)
+			}
+		}
+	}
+	return nil
+}
+
 // securityGroupRuleExpand transform a state rule to an api one.
 func securityGroupRuleExpand(i interface{}) (*instance.SecurityGroupRule, error) {
 	rawRule := i.(map[string]interface{})
@@ -560,3 +641,47 @@ func securityGroupRuleEquals(ruleA, ruleB *instance.SecurityGroupRule) (bool, er
 		ipEqual &&
 		ruleA.Protocol == ruleB.Protocol, nil
 }
+
+// securityGroupSSHLockoutWarning returns a warning when the default policies and the rules of a security group drop SSH traffic.
+func securityGroupSSHLockoutWarning(stateful bool, inboundPolicy, outboundPolicy string, inboundRules, outboundRules []interface{}) string {
+	if inboundPolicy == instance.SecurityGroupPolicyDrop.String() && !securityGroupRulesAcceptPort(inboundRules, 22) {
+		return "inbound_default_policy is drop and no inbound_rule accepts SSH (TCP port 22), servers using this security group may become unreachable"
+	}
+
+	// Without stateful filtering, the replies of the SSH server must be accepted on every port
+	if !stateful && outboundPolicy == instance.SecurityGroupPolicyDrop.String() && !securityGroupRulesAcceptPort(outboundRules, 0) {
+		return "stateful is false, outbound_default_policy is drop and no outbound_rule accepts TCP traffic on all ports, SSH replies may be dropped"
+	}
+
+	return ""
+}
+
+// securityGroupRulesAcceptPort returns true when a rule accepts TCP traffic on the given port, or on all ports when port is 0.
+func securityGroupRulesAcceptPort(rawRules []interface{}, port uint32) bool {
+	for _, rawRule := range rawRules {
+		rule, err := securityGroupRuleExpand(rawRule)
+		if err != nil {
+			continue
+		}
+		if rule.Action != instance.SecurityGroupRuleActionAccept {
+			continue
+		}
+		if rule.Protocol != instance.SecurityGroupRuleProtocolTCP && rule.Protocol != instance.SecurityGroupRuleProtocolANY {
+			continue
+		}
+		if rule.DestPortFrom == nil {
+			return true
+		}
+		if port == 0 {
+			continue
+		}
+		portTo := *rule.DestPortFrom
+		if rule.DestPortTo != nil {
+			portTo = *rule.DestPortTo
+		}
+		if *rule.DestPortFrom <= port && port <= portTo {
+			return true
+		}
+	}
+	return false
+}
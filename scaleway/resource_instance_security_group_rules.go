@@ -13,6 +13,7 @@ func resourceScalewayInstanceSecurityGroupRules() *schema.Resource {
 		ReadContext:   resourceScalewayInstanceSecurityGroupRulesRead,
 		UpdateContext: resourceScalewayInstanceSecurityGroupRulesUpdate,
 		DeleteContext: resourceScalewayInstanceSecurityGroupRulesDelete,
+		CustomizeDiff: customizeDiffInstanceSecurityGroupRulesPorts,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
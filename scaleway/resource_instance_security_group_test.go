@@ -590,3 +590,48 @@ func TestAccScalewayInstanceSecurityGroup_EnableDefaultSecurity(t *testing.T) {
 		},
 	})
 }
+
+func TestSecurityGroupSSHLockoutWarning(t *testing.T) {
+	rule := func(action string, protocol string, port int, portRange string) interface{} {
+		return map[string]interface{}{
+			"action":     action,
+			"protocol":   protocol,
+			"port":       port,
+			"port_range": portRange,
+			"ip":         "",
+			"ip_range":   "",
+		}
+	}
+
+	// Accepting policies never lock out SSH
+	assert.Empty(t, securityGroupSSHLockoutWarning(false, "accept", "accept", nil, nil))
+
+	// Inbound SSH must be accepted by a rule when inbound traffic is dropped by default
+	assert.NotEmpty(t, securityGroupSSHLockoutWarning(true, "drop", "accept", nil, nil))
+	assert.NotEmpty(t, securityGroupSSHLockoutWarning(true, "drop", "accept", []interface{}{rule("accept", "TCP", 80, "")}, nil))
+	assert.NotEmpty(t, securityGroupSSHLockoutWarning(true, "drop", "accept", []interface{}{rule("drop", "TCP", 22, "")}, nil))
+	assert.NotEmpty(t, securityGroupSSHLockoutWarning(true, "drop", "accept", []interface{}{rule("accept", "UDP", 22, "")}, nil))
+	assert.Empty(t, securityGroupSSHLockoutWarning(true, "drop", "accept", []interface{}{rule("accept", "TCP", 22, "")}, nil))
+	assert.Empty(t, securityGroupSSHLockoutWarning(true, "drop", "accept", []interface{}{rule("accept", "TCP", 0, "20-25")}, nil))
+	assert.Empty(t, securityGroupSSHLockoutWarning(true, "drop", "accept", []interface{}{rule("accept", "ANY", 0, "")}, nil))
+
+	// Stateful security groups accept the replies of the SSH server
+	assert.Empty(t, securityGroupSSHLockoutWarning(true, "accept", "drop", nil, nil))
+
+	// Stateless security groups must accept outbound TCP traffic on all ports
+	assert.NotEmpty(t, securityGroupSSHLockoutWarning(false, "accept", "drop", nil, nil))
+	assert.NotEmpty(t, securityGroupSSHLockoutWarning(false, "accept", "drop", nil, []interface{}{rule("accept", "TCP", 443, "")}))
+	assert.Empty(t, securityGroupSSHLockoutWarning(false, "accept", "drop", nil, []interface{}{rule("accept", "TCP", 0, "")}))
+}
+
+func TestValidateSecurityGroupRulePortRange(t *testing.T) {
+	validate := validateSecurityGroupRulePortRange()
+	for _, portRange := range []string{"1-1024", "22-22", "22-23", "10000-18191", "0-65535"} {
+		_, errs := validate(portRange, "inbound_rule.0.port_range")
+		assert.Empty(t, errs, portRange)
+	}
+	for _, portRange := range []string{"", "22", "1024-1", "1-65536", "1-1000", "a-b", "-1-22", "1 - 22"} {
+		_, errs := validate(portRange, "inbound_rule.0.port_range")
+		assert.Len(t, errs, 1, portRange)
+	}
+}
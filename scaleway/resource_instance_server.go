@@ -1,20 +1,18 @@
 package scaleway
 
 import (
-	"bytes"
 	"context"
 	"fmt"
-	"io"
 	"io/ioutil"
 	"strconv"
 	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/scaleway/scaleway-sdk-go/api/instance/v1"
-	"github.com/scaleway/scaleway-sdk-go/api/marketplace/v1"
 	"github.com/scaleway/scaleway-sdk-go/scw"
 	scwvalidation "github.com/scaleway/scaleway-sdk-go/validation"
 )
@@ -29,8 +27,12 @@ func resourceScalewayInstanceServer() *schema.Resource {
 		ReadContext:   resourceScalewayInstanceServerRead,
 		UpdateContext: resourceScalewayInstanceServerUpdate,
 		DeleteContext: resourceScalewayInstanceServerDelete,
+		CustomizeDiff: customdiff.All(
+			customizeDiffInstanceServerImage,
+			customizeDiffInstanceServerRootVolumeSize,
+		),
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: resourceScalewayInstanceServerImport,
 		},
 		Timeouts: &schema.ResourceTimeout{
 			Default: schema.DefaultTimeout(defaultInstanceServerWaitTimeout),
@@ -46,10 +48,15 @@ func resourceScalewayInstanceServer() *schema.Resource {
 			"image": {
 				Type:             schema.TypeString,
 				Required:         true,
-				ForceNew:         true,
 				Description:      "The UUID or the label of the base image used by the server",
 				DiffSuppressFunc: diffSuppressFuncLocality,
 			},
+			"replace_root_volume_on_image_change": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Replace the root volume instead of the server when the image changes",
+			},
 			"type": {
 				Type:             schema.TypeString,
 				Required:         true,
@@ -95,8 +102,7 @@ func resourceScalewayInstanceServer() *schema.Resource {
 							Type:        schema.TypeInt,
 							Optional:    true,
 							Computed:    true,
-							ForceNew:    true,
-							Description: "Size of the root volume in gigabytes",
+							Description: "Size of the root volume in gigabytes, block root volumes can be grown without recreating the server",
 						},
 						"delete_on_termination": {
 							Type:        schema.TypeBool,
@@ -171,6 +177,12 @@ func resourceScalewayInstanceServer() *schema.Resource {
 				Default:     false,
 				Description: "Enable dynamic IP on the server",
 			},
+			"protected": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Protect the server from being deleted",
+			},
 			"state": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -186,7 +198,7 @@ func resourceScalewayInstanceServer() *schema.Resource {
 				Type:        schema.TypeString,
 				Optional:    true,
 				Description: "The boot type of the server",
-				Default:     instance.BootTypeLocal,
+				Default:     instance.BootTypeLocal.String(),
 				ValidateFunc: validation.StringInSlice([]string{
 					instance.BootTypeLocal.String(),
 					instance.BootTypeRescue.String(),
@@ -214,6 +226,60 @@ func resourceScalewayInstanceServer() *schema.Resource {
 					Type: schema.TypeString,
 				},
 			},
+			"cloud_init_part": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				Description:   "Parts of a multipart cloud-init document associated with the server",
+				ConflictsWith: []string{"cloud_init"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"content_type": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The MIME type of the part, such as text/x-shellscript or text/cloud-config",
+						},
+						"content": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The content of the part",
+						},
+						"filename": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The filename of the part",
+						},
+					},
+				},
+			},
+			"cloud_init_gzip": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Gzip the multipart cloud-init document built from cloud_init_part",
+			},
+			"cloud_init_hash": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The SHA256 hash of the cloud-init user data of the server",
+			},
+			"stop_mode": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  InstanceServerStopModePoweroff,
+				ValidateFunc: validation.StringInSlice([]string{
+					InstanceServerStopModePoweroff,
+					InstanceServerStopModeTerminate,
+				}, false),
+				Description: "How the server is stopped when it is destroyed",
+			},
+			"shutdown_timeout": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          defaultInstanceServerWaitTimeout.String(),
+				ValidateFunc:     validateDuration(),
+				DiffSuppressFunc: diffSuppressFuncDuration,
+				Description:      "The maximum duration to wait for the server to stop",
+			},
 			"private_network": {
 				Type:        schema.TypeList,
 				Optional:    true,
@@ -259,21 +325,131 @@ func resourceScalewayInstanceServerCreate(ctx context.Context, d *schema.Resourc
 	// Create the server
 	////
 
-	commercialType := d.Get("type").(string)
+	var res *instance.CreateServerResponse
+	candidateZones := instanceServerCandidateZones(d, meta, zone)
+	for i, candidateZone := range candidateZones {
+		req, err := expandInstanceServerCreateRequest(d, meta, instanceAPI, candidateZone)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		res, err = instanceAPI.CreateServer(req, scw.WithContext(ctx))
+		if err == nil {
+			zone = candidateZone
+			break
+		}
+		if !isOutOfStockError(err) || i == len(candidateZones)-1 {
+			return diag.FromErr(err)
+		}
+		l.Warningf("server type %s is out of stock in %s, trying %s", req.CommercialType, candidateZone, candidateZones[i+1])
+	}
 
-	imageUUID := expandZonedID(d.Get("image")).ID
-	if !scwvalidation.IsUUID(imageUUID) {
-		marketPlaceAPI := marketplace.NewAPI(meta.(*Meta).scwClient)
-		imageUUID, err = marketPlaceAPI.GetLocalImageIDByLabel(&marketplace.GetLocalImageIDByLabelRequest{
-			CommercialType: commercialType,
-			Zone:           zone,
-			ImageLabel:     imageUUID,
+	_, err = instanceAPI.WaitForServer(&instance.WaitForServerRequest{
+		Zone:          zone,
+		ServerID:      res.Server.ID,
+		Timeout:       scw.TimeDurationPtr(defaultInstanceServerWaitTimeout),
+		RetryInterval: waitRetryInterval(meta, retryInstanceServerInterval),
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(newZonedID(zone, res.Server.ID).String())
+
+	if d.Get("protected").(bool) {
+		_, err = instanceAPI.UpdateServer(&instance.UpdateServerRequest{
+			Zone:      zone,
+			ServerID:  res.Server.ID,
+			Protected: scw.BoolPtr(true),
+		}, scw.WithContext(ctx))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	////
+	// Set user data
+	////
+	userData, err := expandInstanceServerUserData(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	userDataRequests := &instance.SetAllServerUserDataRequest{
+		Zone:     zone,
+		ServerID: res.Server.ID,
+		UserData: userData,
+	}
+
+	if len(userDataRequests.UserData) > 0 {
+		_, err := instanceAPI.WaitForServer(&instance.WaitForServerRequest{
+			Zone:          zone,
+			ServerID:      res.Server.ID,
+			Timeout:       scw.TimeDurationPtr(defaultInstanceServerWaitTimeout),
+			RetryInterval: waitRetryInterval(meta, retryInstanceServerInterval),
 		})
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("could not get image '%s': %s", newZonedID(zone, imageUUID), err))
+			return diag.FromErr(err)
+		}
+
+		err = instanceAPI.SetAllServerUserData(userDataRequests)
+		if err != nil {
+			return diag.FromErr(err)
 		}
 	}
 
+	targetState, err := serverStateExpand(d.Get("state").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = reachState(ctx, meta, instanceAPI, zone, res.Server.ID, targetState, expandInstanceServerShutdownTimeout(d))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	////
+	// Private Network
+	////
+	if rawPNICs, ok := d.GetOk("private_network"); ok {
+		vpcAPI, err := vpcAPI(meta)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		pnRequest, err := preparePrivateNIC(ctx, rawPNICs, res.Server, vpcAPI)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		// compute attachment
+		for _, q := range pnRequest {
+			_, err := instanceAPI.WaitForServer(&instance.WaitForServerRequest{
+				Zone:          zone,
+				ServerID:      res.Server.ID,
+				Timeout:       scw.TimeDurationPtr(defaultInstanceServerWaitTimeout),
+				RetryInterval: waitRetryInterval(meta, retryInstanceServerInterval),
+			})
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			_, err = instanceAPI.CreatePrivateNIC(q, scw.WithContext(ctx))
+			if err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
+	return resourceScalewayInstanceServerRead(ctx, d, meta)
+}
+
+// expandInstanceServerCreateRequest builds the server creation request for the given zone.
+func expandInstanceServerCreateRequest(d *schema.ResourceData, meta interface{}, instanceAPI *instance.API, zone scw.Zone) (*instance.CreateServerRequest, error) {
+	var err error
+	commercialType := d.Get("type").(string)
+
+	imageUUID, err := instanceServerImageUUID(meta, zone, commercialType, d.Get("image").(string))
+	if err != nil {
+		return nil, err
+	}
+
 	req := &instance.CreateServerRequest{
 		Zone:              zone,
 		Name:              expandOrGenerateString(d.Get("name"), "srv"),
@@ -305,7 +481,7 @@ func resourceScalewayInstanceServerCreate(ctx context.Context, d *schema.Resourc
 
 	serverType := getServerType(instanceAPI, req.Zone, req.CommercialType)
 	if serverType == nil {
-		return diag.FromErr(fmt.Errorf("could not find a server type associated with %s", req.CommercialType))
+		return nil, fmt.Errorf("could not find a server type associated with %s", req.CommercialType)
 	}
 
 	req.Volumes = make(map[string]*instance.VolumeServerTemplate)
@@ -344,7 +520,7 @@ func resourceScalewayInstanceServerCreate(ctx context.Context, d *schema.Resourc
 				VolumeID: expandZonedID(volumeID).ID,
 			})
 			if err != nil {
-				return diag.FromErr(err)
+				return nil, err
 			}
 			req.Volumes[strconv.Itoa(i+1)] = &instance.VolumeServerTemplate{
 				ID:         vol.Volume.ID,
@@ -357,107 +533,23 @@ func resourceScalewayInstanceServerCreate(ctx context.Context, d *schema.Resourc
 
 	// Validate total local volume sizes.
 	if err = validateLocalVolumeSizes(req.Volumes, serverType, req.CommercialType); err != nil {
-		return diag.FromErr(err)
+		return nil, err
 	}
 
 	// Sanitize the volume map to respect API schemas
 	req.Volumes = sanitizeVolumeMap(req.Name, req.Volumes)
 
-	res, err := instanceAPI.CreateServer(req, scw.WithContext(ctx))
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	_, err = instanceAPI.WaitForServer(&instance.WaitForServerRequest{
-		Zone:          zone,
-		ServerID:      res.Server.ID,
-		Timeout:       scw.TimeDurationPtr(defaultInstanceServerWaitTimeout),
-		RetryInterval: scw.TimeDurationPtr(retryInstanceServerInterval),
-	})
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	d.SetId(newZonedID(zone, res.Server.ID).String())
-
-	////
-	// Set user data
-	////
-	userDataRequests := &instance.SetAllServerUserDataRequest{
-		Zone:     zone,
-		ServerID: res.Server.ID,
-		UserData: make(map[string]io.Reader),
-	}
-
-	if rawUserData, ok := d.GetOk("user_data"); ok {
-		for key, value := range rawUserData.(map[string]interface{}) {
-			userDataRequests.UserData[key] = bytes.NewBufferString(value.(string))
-		}
-	}
-
-	// cloud init script is set in user data
-	if cloudInit, ok := d.GetOk("cloud_init"); ok {
-		userDataRequests.UserData["cloud-init"] = bytes.NewBufferString(cloudInit.(string))
-	}
-
-	if len(userDataRequests.UserData) > 0 {
-		_, err := instanceAPI.WaitForServer(&instance.WaitForServerRequest{
-			Zone:          zone,
-			ServerID:      res.Server.ID,
-			Timeout:       scw.TimeDurationPtr(defaultInstanceServerWaitTimeout),
-			RetryInterval: scw.TimeDurationPtr(retryInstanceServerInterval),
-		})
-		if err != nil {
-			return diag.FromErr(err)
-		}
-
-		err = instanceAPI.SetAllServerUserData(userDataRequests)
-		if err != nil {
-			return diag.FromErr(err)
-		}
-	}
-
-	targetState, err := serverStateExpand(d.Get("state").(string))
-	if err != nil {
-		return diag.FromErr(err)
-	}
-	err = reachState(ctx, instanceAPI, zone, res.Server.ID, targetState)
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	////
-	// Private Network
-	////
-	if rawPNICs, ok := d.GetOk("private_network"); ok {
-		vpcAPI, err := vpcAPI(meta)
-		if err != nil {
-			return diag.FromErr(err)
-		}
-		pnRequest, err := preparePrivateNIC(ctx, rawPNICs, res.Server, vpcAPI)
-		if err != nil {
-			return diag.FromErr(err)
-		}
-		// compute attachment
-		for _, q := range pnRequest {
-			_, err := instanceAPI.WaitForServer(&instance.WaitForServerRequest{
-				Zone:          zone,
-				ServerID:      res.Server.ID,
-				Timeout:       scw.TimeDurationPtr(defaultInstanceServerWaitTimeout),
-				RetryInterval: scw.TimeDurationPtr(retryInstanceServerInterval),
-			})
-			if err != nil {
-				return diag.FromErr(err)
-			}
+	return req, nil
+}
 
-			_, err = instanceAPI.CreatePrivateNIC(q, scw.WithContext(ctx))
-			if err != nil {
-				return diag.FromErr(err)
-			}
-		}
-	}
+func resourceScalewayInstanceServerImport(_ context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	// These fields are not stored by the API
+	_ = d.Set("stop_mode", InstanceServerStopModePoweroff)
+	_ = d.Set("shutdown_timeout", defaultInstanceServerWaitTimeout.String())
+	_ = d.Set("cloud_init_gzip", false)
+	_ = d.Set("replace_root_volume_on_image_change", false)
 
-	return resourceScalewayInstanceServerRead(ctx, d, meta)
+	return []*schema.ResourceData{d}, nil
 }
 
 func resourceScalewayInstanceServerRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -470,7 +562,7 @@ func resourceScalewayInstanceServerRead(ctx context.Context, d *schema.ResourceD
 		Zone:          zone,
 		ServerID:      ID,
 		Timeout:       scw.TimeDurationPtr(defaultInstanceServerWaitTimeout),
-		RetryInterval: scw.TimeDurationPtr(retryInstanceServerInterval),
+		RetryInterval: waitRetryInterval(meta, retryInstanceServerInterval),
 	})
 	if err != nil {
 		return diag.FromErr(err)
@@ -489,16 +581,19 @@ func resourceScalewayInstanceServerRead(ctx context.Context, d *schema.ResourceD
 	_ = d.Set("boot_type", server.BootType)
 	_ = d.Set("bootscript_id", server.Bootscript.ID)
 	_ = d.Set("type", server.CommercialType)
-	_ = d.Set("tags", server.Tags)
+	_ = d.Set("tags", meta.(*Meta).ignoreTags.filter(server.Tags))
 	_ = d.Set("security_group_id", newZonedID(zone, server.SecurityGroup.ID).String())
 	_ = d.Set("enable_ipv6", server.EnableIPv6)
 	_ = d.Set("enable_dynamic_ip", server.DynamicIPRequired)
+	_ = d.Set("protected", server.Protected)
 	_ = d.Set("organization_id", server.Organization)
 	_ = d.Set("project_id", server.Project)
 
 	// Image could be empty in an import context.
 	image := expandRegionalID(d.Get("image").(string))
-	if server.Image != nil && (image.ID == "" || scwvalidation.IsUUID(image.ID)) {
+	// The image of the server is not updated when its root volume is replaced.
+	imageReplaced := d.Get("replace_root_volume_on_image_change").(bool) && image.ID != ""
+	if server.Image != nil && (image.ID == "" || scwvalidation.IsUUID(image.ID)) && !imageReplaced {
 		// TODO: If image is a label, check that server.Image.ID match the label.
 		// It could be useful if the user edit the image with another tool.
 		_ = d.Set("image", newZonedID(zone, server.Image.ID).String())
@@ -574,21 +669,39 @@ func resourceScalewayInstanceServerRead(ctx context.Context, d *schema.ResourceD
 		ServerID: ID,
 	}, scw.WithContext(ctx))
 
+	// The cloud-init user data is read in user_data unless it is set from cloud_init or cloud_init_part.
+	_, cloudInitPartsSet := d.GetOk("cloud_init_part")
+	cloudInitSet := d.Get("cloud_init").(string) != "" || cloudInitPartsSet
 	userData := make(map[string]interface{})
+	cloudInitHash := ""
 	for key, value := range allUserData.UserData {
 		userDataValue, err := ioutil.ReadAll(value)
 		if err != nil {
 			return diag.FromErr(err)
 		}
-		//if key != "cloud-init" {
+		if key == "cloud-init" {
+			cloudInitHash = instanceUserDataHash(userDataValue)
+			if cloudInitSet {
+				continue
+			}
+		}
 		userData[key] = string(userDataValue)
-		//	} else {
-		//_ = d.Set("cloud_init", string(userDataValue))
-		//}
 	}
-	if len(userData) > 0 {
+	if len(userData) > 0 || len(d.Get("user_data").(map[string]interface{})) > 0 {
 		_ = d.Set("user_data", userData)
 	}
+	_ = d.Set("cloud_init_hash", cloudInitHash)
+
+	// When the cloud-init user data was changed outside of terraform, the parts are removed from the state to trigger an update.
+	if rawParts, ok := d.GetOk("cloud_init_part"); ok {
+		cloudInit, err := instanceCloudInitMultipart(expandInstanceCloudInitParts(rawParts), d.Get("cloud_init_gzip").(bool))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if instanceUserDataHash(cloudInit) != cloudInitHash {
+			_ = d.Set("cloud_init_part", nil)
+		}
+	}
 
 	////
 	// Read server private networks
@@ -607,6 +720,157 @@ func resourceScalewayInstanceServerRead(ctx context.Context, d *schema.ResourceD
 	return nil
 }
 
+// customizeDiffInstanceServerRootVolumeSize recreates the server when its root volume is shrunk or is not a block volume,
+// block root volumes are grown in place.
+func customizeDiffInstanceServerRootVolumeSize(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" || !diff.HasChange("root_volume.0.size_in_gb") {
+		return nil
+	}
+
+	oldSize, newSize := diff.GetChange("root_volume.0.size_in_gb")
+	if newSize.(int) < oldSize.(int) {
+		return diff.ForceNew("root_volume.0.size_in_gb")
+	}
+
+	instanceAPI, zone, volumeID, err := instanceAPIWithZoneAndID(meta, diff.Get("root_volume.0.volume_id").(string))
+	if err != nil {
+		return err
+	}
+
+	volume, err := instanceAPI.GetVolume(&instance.GetVolumeRequest{
+		Zone:     zone,
+		VolumeID: volumeID,
+	}, scw.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+
+	if volume.Volume.VolumeType != instance.VolumeVolumeTypeBSSD {
+		return diff.ForceNew("root_volume.0.size_in_gb")
+	}
+
+	return nil
+}
+
+// customizeDiffInstanceServerImage recreates the server when its image changes, unless its root volume can be replaced.
+func customizeDiffInstanceServerImage(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	if diff.Id() == "" || !diff.HasChange("image") || diff.Get("replace_root_volume_on_image_change").(bool) {
+		return nil
+	}
+	return diff.ForceNew("image")
+}
+
+// replaceInstanceServerRootVolume stops the server and replaces its root volume by a new volume created from the image,
+// the additional volumes and the IPs of the server are kept. It returns the ID of the new root volume.
+func replaceInstanceServerRootVolume(ctx context.Context, d *schema.ResourceData, meta interface{}, instanceAPI *instance.API, zone scw.Zone, serverID string) (string, error) {
+	imageUUID, err := instanceServerImageUUID(meta, zone, d.Get("type").(string), d.Get("image").(string))
+	if err != nil {
+		return "", err
+	}
+
+	image, err := instanceAPI.GetImage(&instance.GetImageRequest{
+		Zone:    zone,
+		ImageID: imageUUID,
+	}, scw.WithContext(ctx))
+	if err != nil {
+		return "", err
+	}
+	if image.Image.RootVolume == nil {
+		return "", fmt.Errorf("image %s has no root volume", imageUUID)
+	}
+
+	err = reachState(ctx, meta, instanceAPI, zone, serverID, instance.ServerStateStopped, expandInstanceServerShutdownTimeout(d))
+	if err != nil {
+		return "", err
+	}
+
+	server, err := instanceAPI.WaitForServer(&instance.WaitForServerRequest{
+		Zone:          zone,
+		ServerID:      serverID,
+		Timeout:       scw.TimeDurationPtr(defaultInstanceServerWaitTimeout),
+		RetryInterval: waitRetryInterval(meta, retryInstanceServerInterval),
+	}, scw.WithContext(ctx))
+	if err != nil {
+		return "", err
+	}
+
+	res, err := instanceAPI.CreateVolume(&instance.CreateVolumeRequest{
+		Zone:         zone,
+		Name:         newRandomName("vol"),
+		VolumeType:   image.Image.RootVolume.VolumeType,
+		Project:      scw.StringPtr(server.Project),
+		BaseSnapshot: scw.StringPtr(image.Image.RootVolume.ID),
+	}, scw.WithContext(ctx))
+	if err != nil {
+		return "", fmt.Errorf("couldn't create root volume: %s", err)
+	}
+
+	_, err = instanceAPI.WaitForVolume(&instance.WaitForVolumeRequest{
+		Zone:          zone,
+		VolumeID:      res.Volume.ID,
+		RetryInterval: waitRetryIntervalOverride(meta),
+	}, scw.WithContext(ctx))
+	if err != nil {
+		return "", err
+	}
+
+	volumes := map[string]*instance.VolumeServerTemplate{}
+	oldRootVolumeID := ""
+	for key, volume := range server.Volumes {
+		if key == "0" {
+			oldRootVolumeID = volume.ID
+			continue
+		}
+		volumes[key] = &instance.VolumeServerTemplate{
+			ID:   volume.ID,
+			Name: newRandomName("vol"), // name is ignored by the API, any name will work here
+		}
+	}
+	volumes["0"] = &instance.VolumeServerTemplate{
+		ID:   res.Volume.ID,
+		Name: newRandomName("vol"), // name is ignored by the API, any name will work here
+	}
+
+	_, err = instanceAPI.UpdateServer(&instance.UpdateServerRequest{
+		Zone:     zone,
+		ServerID: serverID,
+		Volumes:  &volumes,
+	}, scw.WithContext(ctx))
+	if err != nil {
+		return "", fmt.Errorf("couldn't replace root volume: %s", err)
+	}
+
+	if size, ok := d.GetOk("root_volume.0.size_in_gb"); ok && res.Volume.VolumeType == instance.VolumeVolumeTypeBSSD && uint64(size.(int))*gb > uint64(res.Volume.Size) {
+		err = instanceVolumeResize(ctx, meta, instanceAPI, zone, res.Volume.ID, size.(int))
+		if err != nil {
+			return "", err
+		}
+	}
+
+	// The previous root volume is kept when it should not be deleted with the server
+	_, rootVolumeAttributeSet := d.GetOk("root_volume")
+	if oldRootVolumeID != "" && (d.Get("root_volume.0.delete_on_termination").(bool) || !rootVolumeAttributeSet) {
+		_, err = instanceAPI.WaitForVolume(&instance.WaitForVolumeRequest{
+			Zone:          zone,
+			VolumeID:      oldRootVolumeID,
+			RetryInterval: waitRetryIntervalOverride(meta),
+		}, scw.WithContext(ctx))
+		if err != nil {
+			return "", err
+		}
+
+		err = instanceAPI.DeleteVolume(&instance.DeleteVolumeRequest{
+			Zone:     zone,
+			VolumeID: oldRootVolumeID,
+		}, scw.WithContext(ctx))
+		if err != nil && !is404Error(err) {
+			return "", err
+		}
+	}
+
+	return res.Volume.ID, nil
+}
+
 func resourceScalewayInstanceServerUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	instanceAPI, zone, ID, err := instanceAPIWithZoneAndID(meta, d.Id())
 	if err != nil {
@@ -622,7 +886,7 @@ func resourceScalewayInstanceServerUpdate(ctx context.Context, d *schema.Resourc
 		Zone:          zone,
 		ServerID:      ID,
 		Timeout:       scw.TimeDurationPtr(defaultInstanceServerWaitTimeout),
-		RetryInterval: scw.TimeDurationPtr(retryInstanceServerInterval),
+		RetryInterval: waitRetryInterval(meta, retryInstanceServerInterval),
 	})
 	if err != nil {
 		return diag.FromErr(err)
@@ -640,7 +904,7 @@ func resourceScalewayInstanceServerUpdate(ctx context.Context, d *schema.Resourc
 	}
 
 	if d.HasChange("tags") {
-		updateRequest.Tags = scw.StringsPtr(expandStrings(d.Get("tags")))
+		updateRequest.Tags = scw.StringsPtr(meta.(*Meta).ignoreTags.merge(expandStrings(d.Get("tags")), server.Tags))
 	}
 
 	if d.HasChange("security_group_id") {
@@ -658,11 +922,29 @@ func resourceScalewayInstanceServerUpdate(ctx context.Context, d *schema.Resourc
 		updateRequest.DynamicIPRequired = scw.BoolPtr(d.Get("enable_dynamic_ip").(bool))
 	}
 
+	if d.HasChange("protected") {
+		updateRequest.Protected = scw.BoolPtr(d.Get("protected").(bool))
+	}
+
+	rootVolumeID := expandZonedID(d.Get("root_volume.0.volume_id")).ID
+
+	////
+	// Replace the root volume
+	////
+	rootVolumeReplaced := false
+	if d.HasChange("image") {
+		rootVolumeID, err = replaceInstanceServerRootVolume(ctx, d, meta, instanceAPI, zone, ID)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		rootVolumeReplaced = true
+	}
+
 	volumes := map[string]*instance.VolumeServerTemplate{}
 
 	if raw, ok := d.GetOk("additional_volume_ids"); d.HasChange("additional_volume_ids") && ok {
 		volumes["0"] = &instance.VolumeServerTemplate{
-			ID:   expandZonedID(d.Get("root_volume.0.volume_id")).ID,
+			ID:   rootVolumeID,
 			Name: newRandomName("vol"), // name is ignored by the API, any name will work here
 		}
 
@@ -706,6 +988,16 @@ func resourceScalewayInstanceServerUpdate(ctx context.Context, d *schema.Resourc
 		}
 	}
 
+	////
+	// Grow the block root volume
+	////
+	if d.HasChange("root_volume.0.size_in_gb") && !rootVolumeReplaced {
+		err = instanceVolumeResize(ctx, meta, instanceAPI, zone, rootVolumeID, d.Get("root_volume.0.size_in_gb").(int))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	////
 	// Update reserved IP
 	////
@@ -714,7 +1006,7 @@ func resourceScalewayInstanceServerUpdate(ctx context.Context, d *schema.Resourc
 			Zone:          zone,
 			ServerID:      ID,
 			Timeout:       scw.TimeDurationPtr(defaultInstanceServerWaitTimeout),
-			RetryInterval: scw.TimeDurationPtr(retryInstanceServerInterval),
+			RetryInterval: waitRetryInterval(meta, retryInstanceServerInterval),
 		})
 
 		if err != nil {
@@ -737,7 +1029,7 @@ func resourceScalewayInstanceServerUpdate(ctx context.Context, d *schema.Resourc
 				Zone:          zone,
 				ServerID:      ID,
 				Timeout:       scw.TimeDurationPtr(defaultInstanceServerWaitTimeout),
-				RetryInterval: scw.TimeDurationPtr(retryInstanceServerInterval),
+				RetryInterval: waitRetryInterval(meta, retryInstanceServerInterval),
 			})
 			if err != nil {
 				return diag.FromErr(err)
@@ -749,7 +1041,7 @@ func resourceScalewayInstanceServerUpdate(ctx context.Context, d *schema.Resourc
 				Zone:          zone,
 				ServerID:      ID,
 				Timeout:       scw.TimeDurationPtr(defaultInstanceServerWaitTimeout),
-				RetryInterval: scw.TimeDurationPtr(retryInstanceServerInterval),
+				RetryInterval: waitRetryInterval(meta, retryInstanceServerInterval),
 			})
 			if err != nil {
 				return diag.FromErr(err)
@@ -768,7 +1060,7 @@ func resourceScalewayInstanceServerUpdate(ctx context.Context, d *schema.Resourc
 				Zone:          zone,
 				ServerID:      ID,
 				Timeout:       scw.TimeDurationPtr(defaultInstanceServerWaitTimeout),
-				RetryInterval: scw.TimeDurationPtr(retryInstanceServerInterval),
+				RetryInterval: waitRetryInterval(meta, retryInstanceServerInterval),
 			})
 			if err != nil {
 				return diag.FromErr(err)
@@ -776,10 +1068,18 @@ func resourceScalewayInstanceServerUpdate(ctx context.Context, d *schema.Resourc
 		}
 	}
 
+	// A running server is rebooted to use its new boot type or bootscript,
+	// this allows to boot in rescue mode and back without recreating the server.
+	// A stopped server uses them when it is started.
+	wasRunning := server.State == instance.ServerStateRunning
+	rebootNeeded := false
+
 	if d.HasChanges("boot_type") {
 		bootType := instance.BootType(d.Get("boot_type").(string))
 		updateRequest.BootType = &bootType
-		if !isStopped {
+		if wantedState == InstanceServerStateStarted && wasRunning {
+			rebootNeeded = true
+		} else if wantedState != InstanceServerStateStarted && !isStopped {
 			warnings = append(warnings, diag.Diagnostic{
 				Severity: diag.Warning,
 				Summary:  "instance may need to be rebooted to use the new boot type",
@@ -789,7 +1089,9 @@ func resourceScalewayInstanceServerUpdate(ctx context.Context, d *schema.Resourc
 
 	if d.HasChanges("bootscript_id") {
 		updateRequest.Bootscript = expandStringPtr(d.Get("bootscript_id").(string))
-		if !isStopped {
+		if wantedState == InstanceServerStateStarted && wasRunning {
+			rebootNeeded = true
+		} else if wantedState != InstanceServerStateStarted && !isStopped {
 			warnings = append(warnings, diag.Diagnostic{
 				Severity: diag.Warning,
 				Summary:  "instance may need to be rebooted to use the new bootscript",
@@ -800,40 +1102,78 @@ func resourceScalewayInstanceServerUpdate(ctx context.Context, d *schema.Resourc
 	////
 	// Update server user data
 	////
-	if d.HasChanges("user_data") {
-		userDataRequests := &instance.SetAllServerUserDataRequest{
-			Zone:     zone,
-			ServerID: ID,
-			UserData: make(map[string]io.Reader),
+	if d.HasChanges("user_data", "cloud_init", "cloud_init_part", "cloud_init_gzip") {
+		userData, err := expandInstanceServerUserData(d)
+		if err != nil {
+			return diag.FromErr(err)
 		}
 
-		if allUserData, ok := d.GetOk("user_data"); ok {
-			userDataMap := allUserData.(map[string]interface{})
-			for key, value := range userDataMap {
-				userDataRequests.UserData[key] = bytes.NewBufferString(value.(string))
-			}
-			if !isStopped && d.HasChange("user_data.cloud-init") {
-				warnings = append(warnings, diag.Diagnostic{
-					Severity: diag.Warning,
-					Summary:  "instance may need to be rebooted to use the new cloud init config",
-				})
-			}
+		if _, ok := userData["cloud-init"]; ok && !isStopped && d.HasChanges("user_data.cloud-init", "cloud_init", "cloud_init_part", "cloud_init_gzip") {
+			warnings = append(warnings, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  "instance may need to be rebooted to use the new cloud init config",
+			})
 		}
 
-		_, err := instanceAPI.WaitForServer(&instance.WaitForServerRequest{
+		_, err = instanceAPI.WaitForServer(&instance.WaitForServerRequest{
 			Zone:          zone,
 			ServerID:      ID,
 			Timeout:       scw.TimeDurationPtr(defaultInstanceServerWaitTimeout),
-			RetryInterval: scw.TimeDurationPtr(retryInstanceServerInterval),
+			RetryInterval: waitRetryInterval(meta, retryInstanceServerInterval),
 		})
 		if err != nil {
 			return diag.FromErr(err)
 		}
 
-		err = instanceAPI.SetAllServerUserData(userDataRequests)
+		// Keys are updated one by one, only the keys removed from the configuration are deleted
+		oldUserData, _ := d.GetChange("user_data")
+		oldCloudInit, _ := d.GetChange("cloud_init")
+		oldCloudInitParts, _ := d.GetChange("cloud_init_part")
+		removedKeys := []string(nil)
+		for key := range oldUserData.(map[string]interface{}) {
+			removedKeys = append(removedKeys, key)
+		}
+		if oldCloudInit.(string) != "" || len(oldCloudInitParts.([]interface{})) > 0 {
+			removedKeys = append(removedKeys, "cloud-init")
+		}
+
+		existingKeys, err := instanceAPI.ListServerUserData(&instance.ListServerUserDataRequest{
+			Zone:     zone,
+			ServerID: ID,
+		}, scw.WithContext(ctx))
 		if err != nil {
 			return diag.FromErr(err)
 		}
+		existing := make(map[string]bool, len(existingKeys.UserData))
+		for _, key := range existingKeys.UserData {
+			existing[key] = true
+		}
+
+		for _, key := range removedKeys {
+			if _, exist := userData[key]; exist || !existing[key] {
+				continue
+			}
+			err = instanceAPI.DeleteServerUserData(&instance.DeleteServerUserDataRequest{
+				Zone:     zone,
+				ServerID: ID,
+				Key:      key,
+			}, scw.WithContext(ctx))
+			if err != nil && !is404Error(err) {
+				return diag.FromErr(err)
+			}
+		}
+
+		for key, value := range userData {
+			err = instanceAPI.SetServerUserData(&instance.SetServerUserDataRequest{
+				Zone:     zone,
+				ServerID: ID,
+				Key:      key,
+				Content:  value,
+			}, scw.WithContext(ctx))
+			if err != nil {
+				return diag.FromErr(err)
+			}
+		}
 	}
 
 	////
@@ -855,7 +1195,7 @@ func resourceScalewayInstanceServerUpdate(ctx context.Context, d *schema.Resourc
 							Zone:          zone,
 							ServerID:      ID,
 							Timeout:       scw.TimeDurationPtr(defaultInstanceServerWaitTimeout),
-							RetryInterval: scw.TimeDurationPtr(retryInstanceServerInterval),
+							RetryInterval: waitRetryInterval(meta, retryInstanceServerInterval),
 						})
 						if err != nil {
 							return diag.FromErr(err)
@@ -882,7 +1222,7 @@ func resourceScalewayInstanceServerUpdate(ctx context.Context, d *schema.Resourc
 						Zone:          zone,
 						ServerID:      ID,
 						Timeout:       scw.TimeDurationPtr(defaultInstanceServerWaitTimeout),
-						RetryInterval: scw.TimeDurationPtr(retryInstanceServerInterval),
+						RetryInterval: waitRetryInterval(meta, retryInstanceServerInterval),
 					})
 					if err != nil {
 						return diag.FromErr(err)
@@ -906,7 +1246,7 @@ func resourceScalewayInstanceServerUpdate(ctx context.Context, d *schema.Resourc
 	}
 
 	// reach expected state
-	err = reachState(ctx, instanceAPI, zone, ID, targetState)
+	err = reachState(ctx, meta, instanceAPI, zone, ID, targetState, expandInstanceServerShutdownTimeout(d))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -915,7 +1255,7 @@ func resourceScalewayInstanceServerUpdate(ctx context.Context, d *schema.Resourc
 		Zone:          zone,
 		ServerID:      ID,
 		Timeout:       scw.TimeDurationPtr(defaultInstanceServerWaitTimeout),
-		RetryInterval: scw.TimeDurationPtr(retryInstanceServerInterval),
+		RetryInterval: waitRetryInterval(meta, retryInstanceServerInterval),
 	})
 	if err != nil {
 		return diag.FromErr(err)
@@ -930,12 +1270,33 @@ func resourceScalewayInstanceServerUpdate(ctx context.Context, d *schema.Resourc
 		Zone:          zone,
 		ServerID:      ID,
 		Timeout:       scw.TimeDurationPtr(defaultInstanceServerWaitTimeout),
-		RetryInterval: scw.TimeDurationPtr(retryInstanceServerInterval),
+		RetryInterval: waitRetryInterval(meta, retryInstanceServerInterval),
 	})
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
+	if rebootNeeded {
+		_, err = instanceAPI.ServerAction(&instance.ServerActionRequest{
+			Zone:     zone,
+			ServerID: ID,
+			Action:   instance.ServerActionReboot,
+		}, scw.WithContext(ctx))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		_, err = instanceAPI.WaitForServer(&instance.WaitForServerRequest{
+			Zone:          zone,
+			ServerID:      ID,
+			Timeout:       scw.TimeDurationPtr(defaultInstanceServerWaitTimeout),
+			RetryInterval: waitRetryInterval(meta, retryInstanceServerInterval),
+		})
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return append(warnings, resourceScalewayInstanceServerRead(ctx, d, meta)...)
 }
 
@@ -945,8 +1306,39 @@ func resourceScalewayInstanceServerDelete(ctx context.Context, d *schema.Resourc
 		return diag.FromErr(err)
 	}
 
+	// The API refuses to delete a protected server, but only after it has been stopped.
+	if d.Get("protected").(bool) {
+		return diag.Errorf("server %s is protected, set protected to false and apply before destroying it", d.Id())
+	}
+
+	// Terminating a running server deletes it with its volumes without archiving them first,
+	// this is only done when no volume has to be kept.
+	if d.Get("stop_mode").(string) == InstanceServerStopModeTerminate && d.Get("state").(string) == InstanceServerStateStarted && instanceServerVolumesDeletable(d) {
+		_, err = instanceAPI.ServerAction(&instance.ServerActionRequest{
+			Zone:     zone,
+			ServerID: ID,
+			Action:   instance.ServerActionTerminate,
+		}, scw.WithContext(ctx))
+		if err != nil && !is404Error(err) {
+			return diag.FromErr(err)
+		}
+
+		_, err = instanceAPI.WaitForServer(&instance.WaitForServerRequest{
+			Zone:          zone,
+			ServerID:      ID,
+			Timeout:       scw.TimeDurationPtr(expandInstanceServerShutdownTimeout(d)),
+			RetryInterval: waitRetryInterval(meta, retryInstanceServerInterval),
+		}, scw.WithContext(ctx))
+		if is404Error(err) {
+			return nil
+		}
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	// reach stopped state
-	err = reachState(ctx, instanceAPI, zone, ID, instance.ServerStateStopped)
+	err = reachState(ctx, meta, instanceAPI, zone, ID, instance.ServerStateStopped, expandInstanceServerShutdownTimeout(d))
 	if is404Error(err) {
 		return nil
 	}
@@ -958,7 +1350,7 @@ func resourceScalewayInstanceServerDelete(ctx context.Context, d *schema.Resourc
 		Zone:          zone,
 		ServerID:      ID,
 		Timeout:       scw.TimeDurationPtr(defaultInstanceServerWaitTimeout),
-		RetryInterval: scw.TimeDurationPtr(retryInstanceServerInterval),
+		RetryInterval: waitRetryInterval(meta, retryInstanceServerInterval),
 	})
 	if err != nil {
 		return diag.FromErr(err)
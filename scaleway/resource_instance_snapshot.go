@@ -103,6 +103,10 @@ func resourceScalewayInstanceSnapshotRead(ctx context.Context, d *schema.Resourc
 	_ = d.Set("name", snapshot.Snapshot.Name)
 	_ = d.Set("created_at", snapshot.Snapshot.CreationDate.Format(time.RFC3339))
 	_ = d.Set("type", snapshot.Snapshot.VolumeType.String())
+	_ = d.Set("size_in_gb", int(snapshot.Snapshot.Size/scw.GB))
+	_ = d.Set("zone", string(zone))
+	_ = d.Set("organization_id", snapshot.Snapshot.Organization)
+	_ = d.Set("project_id", snapshot.Snapshot.Project)
 
 	return nil
 }
@@ -138,7 +142,7 @@ func resourceScalewayInstanceSnapshotDelete(ctx context.Context, d *schema.Resou
 	_, err = instanceAPI.WaitForSnapshot(&instance.WaitForSnapshotRequest{
 		SnapshotID:    id,
 		Zone:          zone,
-		RetryInterval: DefaultWaitRetryInterval,
+		RetryInterval: waitRetryIntervalOverride(meta),
 	})
 	if err != nil {
 		return diag.FromErr(err)
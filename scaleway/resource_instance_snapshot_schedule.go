@@ -0,0 +1,235 @@
+package scaleway
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/scaleway/scaleway-sdk-go/api/instance/v1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+)
+
+func resourceScalewayInstanceSnapshotSchedule() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceScalewayInstanceSnapshotScheduleCreate,
+		ReadContext:   resourceScalewayInstanceSnapshotScheduleRead,
+		UpdateContext: resourceScalewayInstanceSnapshotScheduleUpdate,
+		DeleteContext: resourceScalewayInstanceSnapshotScheduleDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Default: schema.DefaultTimeout(defaultInstanceSnapshotWaitTimeout),
+		},
+		SchemaVersion: 0,
+		Schema: map[string]*schema.Schema{
+			"name_prefix": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The prefix of the name of the snapshots taken by the schedule",
+			},
+			"volume_ids": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Description: "IDs of the volumes to snapshot",
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validationUUIDorUUIDWithLocality(),
+				},
+			},
+			"trigger": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "A value whose changes take new snapshots of the volumes, such as the ID of a time_rotating resource",
+			},
+			"retention": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      7,
+				Description:  "The number of snapshots kept for each volume",
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"snapshot_ids": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "IDs of the snapshots taken by the schedule, oldest first",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"last_run_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date and time of the latest snapshot",
+			},
+			"zone":       zoneSchema(),
+			"project_id": projectIDSchema(),
+		},
+	}
+}
+
+func resourceScalewayInstanceSnapshotScheduleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceAPI, zone, err := instanceAPIWithZone(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	namePrefix := expandOrGenerateString(d.Get("name_prefix"), "snp-schedule")
+	d.SetId(newZonedIDString(zone, namePrefix))
+
+	err = instanceSnapshotScheduleRun(ctx, meta, instanceAPI, zone, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceScalewayInstanceSnapshotScheduleRead(ctx, d, meta)
+}
+
+func resourceScalewayInstanceSnapshotScheduleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceAPI, zone, namePrefix, err := instanceAPIWithZoneAndID(meta, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	snapshots, err := instanceSnapshotScheduleList(ctx, instanceAPI, zone, namePrefix, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	snapshotIDs := []string(nil)
+	for _, snapshot := range snapshots {
+		snapshotIDs = append(snapshotIDs, newZonedIDString(zone, snapshot.ID))
+	}
+
+	// The volumes of an imported schedule are the ones of its snapshots.
+	if len(d.Get("volume_ids").([]interface{})) == 0 {
+		_ = d.Set("volume_ids", instanceSnapshotScheduleVolumeIDs(zone, snapshots))
+	}
+
+	_ = d.Set("name_prefix", namePrefix)
+	_ = d.Set("zone", string(zone))
+	_ = d.Set("snapshot_ids", snapshotIDs)
+	_ = d.Set("last_run_at", flattenTime(instanceSnapshotScheduleLastRun(snapshots)))
+
+	return nil
+}
+
+func resourceScalewayInstanceSnapshotScheduleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceAPI, zone, err := instanceAPIWithZone(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChanges("trigger", "volume_ids") {
+		err = instanceSnapshotScheduleRun(ctx, meta, instanceAPI, zone, d)
+	} else {
+		err = instanceSnapshotScheduleCleanup(ctx, meta, instanceAPI, zone, d)
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceScalewayInstanceSnapshotScheduleRead(ctx, d, meta)
+}
+
+func resourceScalewayInstanceSnapshotScheduleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceAPI, zone, namePrefix, err := instanceAPIWithZoneAndID(meta, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	snapshots, err := instanceSnapshotScheduleList(ctx, instanceAPI, zone, namePrefix, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = instanceSnapshotScheduleDeleteSnapshots(ctx, meta, instanceAPI, zone, snapshots)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// instanceSnapshotScheduleList lists the snapshots of the schedule of all the volumes, oldest first
+func instanceSnapshotScheduleList(ctx context.Context, instanceAPI *instance.API, zone scw.Zone, namePrefix string, d *schema.ResourceData) ([]*instance.Snapshot, error) {
+	res, err := instanceAPI.ListSnapshots(&instance.ListSnapshotsRequest{
+		Zone:    zone,
+		Name:    scw.StringPtr(namePrefix),
+		Project: expandStringPtr(d.Get("project_id")),
+	}, scw.WithAllPages(), scw.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	return instanceSnapshotScheduleSnapshots(res.Snapshots, namePrefix, nil), nil
+}
+
+// instanceSnapshotScheduleRun snapshots all the volumes of the schedule then removes the expired snapshots
+func instanceSnapshotScheduleRun(ctx context.Context, meta interface{}, instanceAPI *instance.API, zone scw.Zone, d *schema.ResourceData) error {
+	namePrefix := expandZonedID(d.Id()).ID
+	now := time.Now()
+
+	for _, volumeID := range d.Get("volume_ids").([]interface{}) {
+		volumeID := expandZonedID(volumeID).ID
+		_, err := instanceAPI.CreateSnapshot(&instance.CreateSnapshotRequest{
+			Zone:     zone,
+			Project:  expandStringPtr(d.Get("project_id")),
+			Name:     instanceSnapshotScheduleName(namePrefix, volumeID, now),
+			VolumeID: volumeID,
+		}, scw.WithContext(ctx))
+		if err != nil {
+			return err
+		}
+	}
+
+	return instanceSnapshotScheduleCleanup(ctx, meta, instanceAPI, zone, d)
+}
+
+// instanceSnapshotScheduleCleanup removes the snapshots exceeding the retention count and the ones of the volumes removed from the schedule
+func instanceSnapshotScheduleCleanup(ctx context.Context, meta interface{}, instanceAPI *instance.API, zone scw.Zone, d *schema.ResourceData) error {
+	snapshots, err := instanceSnapshotScheduleList(ctx, instanceAPI, zone, expandZonedID(d.Id()).ID, d)
+	if err != nil {
+		return err
+	}
+
+	volumeIDs := []string(nil)
+	for _, volumeID := range d.Get("volume_ids").([]interface{}) {
+		volumeIDs = append(volumeIDs, expandZonedID(volumeID).ID)
+	}
+	volumeSnapshots := instanceSnapshotScheduleSnapshots(snapshots, expandZonedID(d.Id()).ID, volumeIDs)
+
+	expired := instanceSnapshotScheduleExpired(volumeSnapshots, d.Get("retention").(int))
+	expired = append(expired, instanceSnapshotScheduleRemoved(snapshots, volumeIDs)...)
+
+	return instanceSnapshotScheduleDeleteSnapshots(ctx, meta, instanceAPI, zone, expired)
+}
+
+// instanceSnapshotScheduleDeleteSnapshots deletes the snapshots once they are available
+func instanceSnapshotScheduleDeleteSnapshots(ctx context.Context, meta interface{}, instanceAPI *instance.API, zone scw.Zone, snapshots []*instance.Snapshot) error {
+	for _, snapshot := range snapshots {
+		_, err := instanceAPI.WaitForSnapshot(&instance.WaitForSnapshotRequest{
+			SnapshotID:    snapshot.ID,
+			Zone:          zone,
+			RetryInterval: waitRetryIntervalOverride(meta),
+		}, scw.WithContext(ctx))
+		if err != nil {
+			return err
+		}
+
+		err = instanceAPI.DeleteSnapshot(&instance.DeleteSnapshotRequest{
+			SnapshotID: snapshot.ID,
+			Zone:       zone,
+		}, scw.WithContext(ctx))
+		if err != nil && !is404Error(err) {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,169 @@
+package scaleway
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/scaleway/scaleway-sdk-go/api/instance/v1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+)
+
+var instanceUserDataKeyRegexp = regexp.MustCompile(`^[a-zA-Z0-9._-]+$`)
+
+func resourceScalewayInstanceUserData() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceScalewayInstanceUserDataCreate,
+		ReadContext:   resourceScalewayInstanceUserDataRead,
+		UpdateContext: resourceScalewayInstanceUserDataUpdate,
+		DeleteContext: resourceScalewayInstanceUserDataDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Default: schema.DefaultTimeout(defaultInstanceServerWaitTimeout),
+		},
+		SchemaVersion: 0,
+		Schema: map[string]*schema.Schema{
+			"server_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "The ID of the server",
+				ValidateFunc: validationUUIDorUUIDWithLocality(),
+			},
+			"key": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "The key of the user data",
+				ValidateFunc: validation.StringMatch(instanceUserDataKeyRegexp, "must only contain letters, digits, dashes, underscores and dots"),
+			},
+			"value": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The value of the user data",
+			},
+			"zone": zoneSchema(),
+		},
+	}
+}
+
+func resourceScalewayInstanceUserDataCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceAPI, zone, err := instanceAPIWithZone(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	serverID := expandZonedID(d.Get("server_id")).ID
+	key := d.Get("key").(string)
+
+	err = setInstanceServerUserData(ctx, meta, instanceAPI, zone, serverID, key, d.Get("value").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(newZonedNestedIDString(zone, serverID, key))
+
+	return resourceScalewayInstanceUserDataRead(ctx, d, meta)
+}
+
+func resourceScalewayInstanceUserDataRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceAPI, zone, key, serverID, err := instanceAPIWithZoneAndNestedID(meta, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	res, err := instanceAPI.GetAllServerUserData(&instance.GetAllServerUserDataRequest{
+		Zone:     zone,
+		ServerID: serverID,
+	}, scw.WithContext(ctx))
+	if err != nil {
+		if is404Error(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	value, exist := res.UserData[key]
+	if !exist {
+		d.SetId("")
+		return nil
+	}
+
+	rawValue, err := ioutil.ReadAll(value)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_ = d.Set("server_id", newZonedIDString(zone, serverID))
+	_ = d.Set("key", key)
+	_ = d.Set("value", string(rawValue))
+	_ = d.Set("zone", string(zone))
+
+	return nil
+}
+
+func resourceScalewayInstanceUserDataUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceAPI, zone, key, serverID, err := instanceAPIWithZoneAndNestedID(meta, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChange("value") {
+		err = setInstanceServerUserData(ctx, meta, instanceAPI, zone, serverID, key, d.Get("value").(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceScalewayInstanceUserDataRead(ctx, d, meta)
+}
+
+func resourceScalewayInstanceUserDataDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceAPI, zone, key, serverID, err := instanceAPIWithZoneAndNestedID(meta, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = instanceAPI.DeleteServerUserData(&instance.DeleteServerUserDataRequest{
+		Zone:     zone,
+		ServerID: serverID,
+		Key:      key,
+	}, scw.WithContext(ctx))
+	if err != nil && !is404Error(err) {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// setInstanceServerUserData sets a single user data of a server once the server is in a stable state
+func setInstanceServerUserData(ctx context.Context, meta interface{}, instanceAPI *instance.API, zone scw.Zone, serverID, key, value string) error {
+	_, err := instanceAPI.WaitForServer(&instance.WaitForServerRequest{
+		Zone:          zone,
+		ServerID:      serverID,
+		Timeout:       scw.TimeDurationPtr(defaultInstanceServerWaitTimeout),
+		RetryInterval: waitRetryInterval(meta, retryInstanceServerInterval),
+	}, scw.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+
+	err = instanceAPI.SetServerUserData(&instance.SetServerUserDataRequest{
+		Zone:     zone,
+		ServerID: serverID,
+		Key:      key,
+		Content:  bytes.NewBufferString(value),
+	}, scw.WithContext(ctx))
+	if err != nil {
+		return fmt.Errorf("couldn't set user data %s: %s", key, err)
+	}
+	return nil
+}
@@ -98,7 +98,24 @@ func resourceScalewayInstanceVolumeCreate(ctx context.Context, d *schema.Resourc
 	}
 
 	if snapshotID, ok := d.GetOk("from_snapshot_id"); ok {
-		createVolumeRequest.BaseSnapshot = expandStringPtr(expandID(snapshotID))
+		snapshotZonedID := expandZonedID(snapshotID)
+		// The API does not copy snapshots between zones, the volume has to be created in the zone of the snapshot.
+		if snapshotZonedID.Zone != "" && snapshotZonedID.Zone != zone {
+			return diag.FromErr(fmt.Errorf("snapshot %s is in zone %s, a volume can only be created from a snapshot of its own zone %s", snapshotZonedID.ID, snapshotZonedID.Zone, zone))
+		}
+
+		// A snapshot can only be restored once it is available
+		_, err = instanceAPI.WaitForSnapshot(&instance.WaitForSnapshotRequest{
+			SnapshotID:    snapshotZonedID.ID,
+			Zone:          zone,
+			Timeout:       scw.TimeDurationPtr(defaultInstanceSnapshotWaitTimeout),
+			RetryInterval: waitRetryIntervalOverride(meta),
+		}, scw.WithContext(ctx))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		createVolumeRequest.BaseSnapshot = expandStringPtr(snapshotZonedID.ID)
 	}
 
 	res, err := instanceAPI.CreateVolume(createVolumeRequest, scw.WithContext(ctx))
@@ -176,29 +193,7 @@ func resourceScalewayInstanceVolumeUpdate(ctx context.Context, d *schema.Resourc
 		if oldSize, newSize := d.GetChange("size_in_gb"); oldSize.(int) > newSize.(int) {
 			return diag.FromErr(fmt.Errorf("block volumes cannot be resized down"))
 		}
-		_, err := instanceAPI.WaitForVolume(&instance.WaitForVolumeRequest{
-			VolumeID:      id,
-			Zone:          zone,
-			RetryInterval: DefaultWaitRetryInterval,
-		}, scw.WithContext(ctx))
-		if err != nil {
-			return diag.FromErr(err)
-		}
-
-		volumeSizeInBytes := scw.Size(uint64(d.Get("size_in_gb").(int)) * gb)
-		_, err = instanceAPI.UpdateVolume(&instance.UpdateVolumeRequest{
-			VolumeID: id,
-			Zone:     zone,
-			Size:     &volumeSizeInBytes,
-		}, scw.WithContext(ctx))
-		if err != nil {
-			return diag.FromErr(fmt.Errorf("couldn't resize volume: %s", err))
-		}
-		_, err = instanceAPI.WaitForVolume(&instance.WaitForVolumeRequest{
-			VolumeID:      id,
-			Zone:          zone,
-			RetryInterval: DefaultWaitRetryInterval,
-		}, scw.WithContext(ctx))
+		err = instanceVolumeResize(ctx, meta, instanceAPI, zone, id, d.Get("size_in_gb").(int))
 		if err != nil {
 			return diag.FromErr(err)
 		}
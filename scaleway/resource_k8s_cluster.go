@@ -23,6 +23,10 @@ func resourceScalewayK8SCluster() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Timeouts: &schema.ResourceTimeout{
+			Create:  schema.DefaultTimeout(K8SClusterWaitForPoolRequiredTimeout),
+			Read:    schema.DefaultTimeout(defaultK8SClusterTimeout),
+			Update:  schema.DefaultTimeout(defaultK8SClusterTimeout),
+			Delete:  schema.DefaultTimeout(K8SClusterWaitForDeletedTimeout),
 			Default: schema.DefaultTimeout(defaultK8SClusterTimeout),
 		},
 		SchemaVersion: 0,
@@ -154,6 +158,18 @@ func resourceScalewayK8SCluster() *schema.Resource {
 				Default:     false,
 				Description: "Delete additional resources like block volumes and loadbalancers on cluster deletion",
 			},
+			"pin_patch_version": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Do not upgrade to the latest patch version when a minor version x.y is used without auto upgrade",
+			},
+			"upgrade_pools_sequentially": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Upgrade the pools one after the other, waiting for each to be ready, when the cluster version changes",
+			},
 			"region":          regionSchema(),
 			"organization_id": organizationIDSchema(),
 			"project_id":      projectIDSchema(),
@@ -181,12 +197,14 @@ func resourceScalewayK8SCluster() *schema.Resource {
 			"kubeconfig": {
 				Type:        schema.TypeList,
 				Computed:    true,
+				Sensitive:   true,
 				Description: "The kubeconfig configuration file of the Kubernetes cluster",
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"config_file": {
 							Type:        schema.TypeString,
 							Computed:    true,
+							Sensitive:   true,
 							Description: "The whole kubeconfig file",
 						},
 						"host": {
@@ -202,11 +220,22 @@ func resourceScalewayK8SCluster() *schema.Resource {
 						"token": {
 							Type:        schema.TypeString,
 							Computed:    true,
+							Sensitive:   true,
 							Description: "The kubernetes cluster admin token",
 						},
 					},
 				},
 			},
+			"host": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The Kubernetes API server URL, taken from the kubeconfig",
+			},
+			"cluster_ca_certificate": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The base64 encoded CA certificate of the Kubernetes cluster, taken from the kubeconfig",
+			},
 			"upgrade_available": {
 				Type:        schema.TypeBool,
 				Computed:    true,
@@ -361,8 +390,9 @@ func resourceScalewayK8SClusterCreate(ctx context.Context, d *schema.ResourceDat
 	version := d.Get("version").(string)
 	versionIsOnlyMinor := len(strings.Split(version, ".")) == 2
 
-	if versionIsOnlyMinor != clusterAutoUpgradeEnabled {
-		return diag.FromErr(fmt.Errorf("minor version x.y must be used with auto upgrade enabled"))
+	err = k8sValidateClusterVersion(version, clusterAutoUpgradeEnabled)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	if versionIsOnlyMinor {
@@ -379,7 +409,7 @@ func resourceScalewayK8SClusterCreate(ctx context.Context, d *schema.ResourceDat
 		return diag.FromErr(err)
 	}
 
-	res, err = waitK8SClusterPool(ctx, k8sAPI, region, res.ID)
+	res, err = waitK8SClusterPool(ctx, meta, k8sAPI, region, res.ID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -398,7 +428,7 @@ func resourceScalewayK8SClusterRead(ctx context.Context, d *schema.ResourceData,
 	////
 	// Read Cluster
 	////
-	cluster, err := waitK8SCluster(ctx, k8sAPI, region, clusterID)
+	cluster, err := waitK8SCluster(ctx, meta, k8sAPI, region, clusterID, d.Timeout(schema.TimeoutRead))
 	if err != nil {
 		if is404Error(err) {
 			d.SetId("")
@@ -425,10 +455,11 @@ func resourceScalewayK8SClusterRead(ctx context.Context, d *schema.ResourceData,
 	_ = d.Set("feature_gates", cluster.FeatureGates)
 	_ = d.Set("admission_plugins", cluster.AdmissionPlugins)
 
-	// if autoupgrade is enabled, we only set the minor k8s version (x.y)
+	// if autoupgrade is enabled or a minor version is used, we only set the minor k8s version (x.y)
 	version := cluster.Version
-	if cluster.AutoUpgrade != nil && cluster.AutoUpgrade.Enabled {
-		version, err = k8sGetMinorVersionFromFull(version)
+	clusterAutoUpgradeEnabled := cluster.AutoUpgrade != nil && cluster.AutoUpgrade.Enabled
+	if clusterAutoUpgradeEnabled || len(strings.Split(d.Get("version").(string), ".")) == 2 {
+		version, err = k8sGetMinorVersionFromFull(cluster.Version)
 		if err != nil {
 			return diag.FromErr(err)
 		}
@@ -443,36 +474,14 @@ func resourceScalewayK8SClusterRead(ctx context.Context, d *schema.ResourceData,
 	////
 	// Read kubeconfig
 	////
-	kubeconfig, err := k8sAPI.GetClusterKubeConfig(&k8s.GetClusterKubeConfigRequest{
-		Region:    region,
-		ClusterID: clusterID,
-	}, scw.WithContext(ctx))
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	kubeconfigServer, err := kubeconfig.GetServer()
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	kubeconfigCa, err := kubeconfig.GetCertificateAuthorityData()
+	kubeconf, err := k8sGetClusterKubeconfig(ctx, k8sAPI, region, clusterID)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	kubeconfigToken, err := kubeconfig.GetToken()
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	kubeconf := map[string]interface{}{}
-	kubeconf["config_file"] = string(kubeconfig.GetRaw())
-	kubeconf["host"] = kubeconfigServer
-	kubeconf["cluster_ca_certificate"] = kubeconfigCa
-	kubeconf["token"] = kubeconfigToken
-
 	_ = d.Set("kubeconfig", []map[string]interface{}{kubeconf})
+	_ = d.Set("host", kubeconf["host"])
+	_ = d.Set("cluster_ca_certificate", kubeconf["cluster_ca_certificate"])
 
 	return nil
 }
@@ -534,8 +543,9 @@ func resourceScalewayK8SClusterUpdate(ctx context.Context, d *schema.ResourceDat
 	version := d.Get("version").(string)
 	versionIsOnlyMinor := len(strings.Split(version, ".")) == 2
 
-	if versionIsOnlyMinor != autoupgradeEnabled {
-		return diag.FromErr(fmt.Errorf("minor version x.y must be used with auto upgrades enabled"))
+	err = k8sValidateClusterVersion(version, autoupgradeEnabled)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	if versionIsOnlyMinor {
@@ -545,7 +555,8 @@ func resourceScalewayK8SClusterUpdate(ctx context.Context, d *schema.ResourceDat
 		}
 	}
 
-	if d.HasChange("version") {
+	// without auto upgrade, a minor version is upgraded to its latest patch on each update unless it is pinned
+	if d.HasChange("version") || k8sClusterTracksLatestPatch(d.Get("version").(string), autoupgradeEnabled, d.Get("pin_patch_version").(bool)) {
 		// maybe it's a change from minor to patch or patch to minor
 		// we need to check the current version
 
@@ -650,7 +661,7 @@ func resourceScalewayK8SClusterUpdate(ctx context.Context, d *schema.ResourceDat
 		return diag.FromErr(err)
 	}
 
-	_, err = waitK8SCluster(ctx, k8sAPI, region, clusterID)
+	_, err = waitK8SCluster(ctx, meta, k8sAPI, region, clusterID, d.Timeout(schema.TimeoutUpdate))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -659,21 +670,29 @@ func resourceScalewayK8SClusterUpdate(ctx context.Context, d *schema.ResourceDat
 	// Upgrade if needed
 	////
 	if canUpgrade {
+		upgradePoolsSequentially := d.Get("upgrade_pools_sequentially").(bool)
 		upgradeRequest := &k8s.UpgradeClusterRequest{
 			Region:       region,
 			ClusterID:    clusterID,
 			Version:      version,
-			UpgradePools: true,
+			UpgradePools: !upgradePoolsSequentially,
 		}
 		_, err = k8sAPI.UpgradeCluster(upgradeRequest)
 		if err != nil {
 			return diag.FromErr(err)
 		}
 
-		_, err = waitK8SCluster(ctx, k8sAPI, region, clusterID)
+		_, err = waitK8SCluster(ctx, meta, k8sAPI, region, clusterID, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			return diag.FromErr(err)
 		}
+
+		if upgradePoolsSequentially {
+			err = k8sUpgradePoolsSequentially(ctx, meta, k8sAPI, region, clusterID, version, d.Timeout(schema.TimeoutUpdate))
+			if err != nil {
+				return diag.FromErr(err)
+			}
+		}
 	}
 
 	return resourceScalewayK8SClusterRead(ctx, d, meta)
@@ -702,7 +721,7 @@ func resourceScalewayK8SClusterDelete(ctx context.Context, d *schema.ResourceDat
 		return diag.FromErr(err)
 	}
 
-	err = waitK8SClusterDeleted(ctx, k8sAPI, region, clusterID)
+	err = waitK8SClusterDeleted(ctx, meta, k8sAPI, region, clusterID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return diag.FromErr(err)
 	}
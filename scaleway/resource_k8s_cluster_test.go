@@ -138,6 +138,8 @@ func TestAccScalewayK8SCluster_Basic(t *testing.T) {
 					resource.TestCheckResourceAttrSet("scaleway_k8s_cluster.minimal", "kubeconfig.0.cluster_ca_certificate"),
 					resource.TestCheckResourceAttrSet("scaleway_k8s_cluster.minimal", "kubeconfig.0.token"),
 					resource.TestCheckResourceAttrSet("scaleway_k8s_cluster.minimal", "apiserver_url"),
+					resource.TestCheckResourceAttrPair("scaleway_k8s_cluster.minimal", "host", "scaleway_k8s_cluster.minimal", "kubeconfig.0.host"),
+					resource.TestCheckResourceAttrPair("scaleway_k8s_cluster.minimal", "cluster_ca_certificate", "scaleway_k8s_cluster.minimal", "kubeconfig.0.cluster_ca_certificate"),
 					resource.TestCheckResourceAttrSet("scaleway_k8s_cluster.minimal", "wildcard_dns"),
 					resource.TestCheckResourceAttr("scaleway_k8s_cluster.minimal", "tags.0", "terraform-test"),
 					resource.TestCheckResourceAttr("scaleway_k8s_cluster.minimal", "tags.1", "scaleway_k8s_cluster"),
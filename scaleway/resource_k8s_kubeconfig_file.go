@@ -0,0 +1,205 @@
+package scaleway
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const k8sKubeconfigFilePermission = 0600
+
+func resourceScalewayK8SKubeconfigFile() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceScalewayK8SKubeconfigFileCreate,
+		ReadContext:   resourceScalewayK8SKubeconfigFileRead,
+		DeleteContext: resourceScalewayK8SKubeconfigFileDelete,
+		SchemaVersion: 0,
+		Schema: map[string]*schema.Schema{
+			"cluster_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "The ID of the cluster",
+				ValidateFunc: validationUUIDorUUIDWithLocality(),
+			},
+			"path": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The local path of the kubeconfig file",
+			},
+			"context_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The name of the cluster, context and user written in the kubeconfig file",
+			},
+			"merge": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				ForceNew:    true,
+				Description: "Merge the kubeconfig in the existing file instead of overwriting it",
+			},
+			"auth_method": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     k8sKubeconfigAuthMethodToken,
+				ForceNew:    true,
+				Description: "The authentication method rendered in the kubeconfig file",
+				ValidateFunc: validation.StringInSlice([]string{
+					k8sKubeconfigAuthMethodToken,
+					k8sKubeconfigAuthMethodCLI,
+				}, false),
+			},
+			"region": regionSchema(),
+		},
+	}
+}
+
+func resourceScalewayK8SKubeconfigFileCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	k8sAPI, region, err := k8sAPIWithRegion(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	clusterID := expandRegionalID(d.Get("cluster_id"))
+	if clusterID.Region != "" {
+		region = clusterID.Region
+	}
+
+	kubeconf, err := k8sGetClusterKubeconfig(ctx, k8sAPI, region, clusterID.ID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	config := []byte(kubeconf["config_file"].(string))
+	if d.Get("auth_method").(string) == k8sKubeconfigAuthMethodCLI {
+		configFile, err := k8sKubeconfigWithCLIAuth(config)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		config = []byte(configFile)
+	}
+
+	// the cluster, context and user share the same name so they can be removed from a merged file
+	contextName := d.Get("context_name").(string)
+	if contextName == "" {
+		contextName, err = k8sKubeconfigCurrentContext(config)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	config, err = k8sKubeconfigRename(config, contextName)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	path := d.Get("path").(string)
+	if d.Get("merge").(bool) {
+		existing, err := os.ReadFile(path)
+		if err != nil && !os.IsNotExist(err) {
+			return diag.FromErr(err)
+		}
+		if len(existing) > 0 {
+			config, err = k8sKubeconfigMerge(existing, config)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
+	err = k8sWriteKubeconfigFile(path, config)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(newRegionalIDString(region, clusterID.ID))
+	_ = d.Set("context_name", contextName)
+
+	return resourceScalewayK8SKubeconfigFileRead(ctx, d, meta)
+}
+
+func resourceScalewayK8SKubeconfigFileRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	region, _, err := parseRegionalID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	config, err := os.ReadFile(d.Get("path").(string))
+	if err != nil {
+		if os.IsNotExist(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	// the file is written again if its context has been removed
+	hasContext, err := k8sKubeconfigHasContext(config, d.Get("context_name").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !hasContext {
+		d.SetId("")
+		return nil
+	}
+
+	_ = d.Set("region", region.String())
+
+	return nil
+}
+
+func resourceScalewayK8SKubeconfigFileDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	path := d.Get("path").(string)
+
+	if !d.Get("merge").(bool) {
+		err := os.Remove(path)
+		if err != nil && !os.IsNotExist(err) {
+			return diag.FromErr(err)
+		}
+		return nil
+	}
+
+	existing, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	config, err := k8sKubeconfigRemove(existing, d.Get("context_name").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = k8sWriteKubeconfigFile(path, config)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// k8sWriteKubeconfigFile writes a kubeconfig file only readable by its owner.
+func k8sWriteKubeconfigFile(path string, config []byte) error {
+	err := os.MkdirAll(filepath.Dir(path), 0700)
+	if err != nil {
+		return err
+	}
+
+	err = os.WriteFile(path, config, k8sKubeconfigFilePermission)
+	if err != nil {
+		return err
+	}
+
+	// WriteFile keeps the permissions of an existing file
+	return os.Chmod(path, k8sKubeconfigFilePermission)
+}
@@ -2,11 +2,13 @@ package scaleway
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/scaleway/scaleway-sdk-go/api/instance/v1"
 	"github.com/scaleway/scaleway-sdk-go/api/k8s/v1"
 	"github.com/scaleway/scaleway-sdk-go/scw"
 )
@@ -17,10 +19,15 @@ func resourceScalewayK8SPool() *schema.Resource {
 		ReadContext:   resourceScalewayK8SPoolRead,
 		UpdateContext: resourceScalewayK8SPoolUpdate,
 		DeleteContext: resourceScalewayK8SPoolDelete,
+		CustomizeDiff: resourceScalewayK8SPoolCustomizeDiff,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Timeouts: &schema.ResourceTimeout{
+			Create:  schema.DefaultTimeout(K8SPoolWaitForReadyTimeout),
+			Read:    schema.DefaultTimeout(defaultK8SPoolTimeout),
+			Update:  schema.DefaultTimeout(K8SPoolWaitForReadyTimeout),
+			Delete:  schema.DefaultTimeout(defaultK8SPoolTimeout),
 			Default: schema.DefaultTimeout(defaultK8SPoolTimeout),
 		},
 		SchemaVersion: 0,
@@ -99,20 +106,35 @@ func resourceScalewayK8SPool() *schema.Resource {
 				Default:     true,
 				Description: "Whether to wait for the pool to be ready",
 			},
-			"placement_group_id": {
-				Type:        schema.TypeString,
+			"wait_for_nodes": {
+				Type:        schema.TypeBool,
 				Optional:    true,
-				ForceNew:    true,
-				Default:     nil,
-				Description: "ID of the placement group",
+				Default:     false,
+				Description: "Whether to wait for all the nodes of the pool to be ready in Kubernetes",
+			},
+			"propagate_tags": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Add the pool and cluster tags to the instance servers of the pool nodes",
+			},
+			"placement_group_id": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				Default:          nil,
+				Description:      "ID of the placement group",
+				ValidateFunc:     validationUUIDorUUIDWithLocality(),
+				DiffSuppressFunc: diffSuppressFuncLocality,
 			},
 			"kubelet_args": {
 				Type: schema.TypeMap,
 				Elem: &schema.Schema{
 					Type: schema.TypeString,
 				},
-				Optional:    true,
-				Description: "The Kubelet arguments to be used by this pool",
+				Optional:         true,
+				Description:      "The Kubelet arguments to be used by this pool",
+				ValidateDiagFunc: validateKubeletArgs(),
 			},
 			"upgrade_policy": {
 				Type:        schema.TypeList,
@@ -197,19 +219,68 @@ func resourceScalewayK8SPool() *schema.Resource {
 	}
 }
 
+// resourceScalewayK8SPoolCustomizeDiff validates the pool sizes and upgrade policy
+// at plan time so errors are reported before the cluster is modified.
+func resourceScalewayK8SPoolCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	// max_size is computed from size when omitted, only enforce it when it is set in the configuration.
+	// It is unknown at creation when omitted, so it must not prevent the other checks.
+	rawConfig := diff.GetRawConfig()
+	maxSizeSet := !rawConfig.IsNull() && !rawConfig.GetAttr("max_size").IsNull()
+	if diff.NewValueKnown("size") && diff.NewValueKnown("min_size") && (!maxSizeSet || diff.NewValueKnown("max_size")) {
+		err := k8sPoolValidateSizes(
+			diff.Get("size").(int),
+			diff.Get("min_size").(int),
+			diff.Get("max_size").(int),
+			maxSizeSet,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	if _, ok := diff.GetOk("upgrade_policy"); ok && diff.NewValueKnown("upgrade_policy") {
+		err := k8sPoolValidateUpgradePolicy(
+			diff.Get("upgrade_policy.0.max_surge").(int),
+			diff.Get("upgrade_policy.0.max_unavailable").(int),
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func resourceScalewayK8SPoolCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	k8sAPI, region, err := k8sAPIWithRegion(d, meta)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
+	// The pool is created in the region of its cluster.
+	clusterID := expandRegionalID(d.Get("cluster_id"))
+	if clusterID.Region != "" {
+		region = clusterID.Region
+	}
+
+	// Pools can be placed in any zone of the cluster region.
+	if rawZone, ok := d.GetOk("zone"); ok {
+		zoneRegion, err := scw.Zone(rawZone.(string)).Region()
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if zoneRegion != region {
+			return diag.FromErr(fmt.Errorf("pool zone %s is not in the region %s of the cluster", rawZone, region))
+		}
+	}
+
 	////
 	// Create pool
 	////
 
 	req := &k8s.CreatePoolRequest{
 		Region:      region,
-		ClusterID:   expandID(d.Get("cluster_id")),
+		ClusterID:   clusterID.ID,
 		Name:        expandOrGenerateString(d.Get("name"), "pool"),
 		NodeType:    d.Get("node_type").(string),
 		Autoscaling: d.Get("autoscaling").(bool),
@@ -264,7 +335,7 @@ func resourceScalewayK8SPoolCreate(ctx context.Context, d *schema.ResourceData,
 	if cluster.Status == k8s.ClusterStatusPoolRequired {
 		waitForCluster = true
 	} else if cluster.Status == k8s.ClusterStatusCreating {
-		_, err = waitK8SCluster(ctx, k8sAPI, region, cluster.ID)
+		_, err = waitK8SCluster(ctx, meta, k8sAPI, region, cluster.ID, d.Timeout(schema.TimeoutCreate))
 		if err != nil {
 			return diag.FromErr(err)
 		}
@@ -278,14 +349,28 @@ func resourceScalewayK8SPoolCreate(ctx context.Context, d *schema.ResourceData,
 	d.SetId(newRegionalIDString(region, res.ID))
 
 	if d.Get("wait_for_pool_ready").(bool) { // wait for the pool to be ready if specified (including all its nodes)
-		err = waitK8SPoolReady(ctx, k8sAPI, region, res.ID)
+		err = waitK8SPoolReady(ctx, meta, k8sAPI, region, res.ID, d.Timeout(schema.TimeoutCreate))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.Get("wait_for_nodes").(bool) {
+		err = waitK8SPoolNodesReady(ctx, k8sAPI, region, res.ID, d.Timeout(schema.TimeoutCreate))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.Get("propagate_tags").(bool) {
+		err = resourceScalewayK8SPoolPropagateTags(ctx, d, meta, k8sAPI, region, res.ID)
 		if err != nil {
 			return diag.FromErr(err)
 		}
 	}
 
 	if waitForCluster {
-		_, err = waitK8SCluster(ctx, k8sAPI, region, cluster.ID)
+		_, err = waitK8SCluster(ctx, meta, k8sAPI, region, cluster.ID, d.Timeout(schema.TimeoutCreate))
 		if err != nil {
 			return diag.FromErr(err)
 		}
@@ -340,11 +425,12 @@ func resourceScalewayK8SPoolRead(ctx context.Context, d *schema.ResourceData, me
 	_ = d.Set("status", pool.Status)
 	_ = d.Set("kubelet_args", flattenKubeletArgs(pool.KubeletArgs))
 	_ = d.Set("zone", pool.Zone)
+	_ = d.Set("region", region.String())
 	_ = d.Set("upgrade_policy", poolUpgradePolicyFlatten(pool))
 
 	if pool.PlacementGroupID != nil {
-		zone := scw.Zone(region + "-1") // Placement groups are zoned resources.
-		_ = d.Set("placement_group_id", newZonedID(zone, *pool.PlacementGroupID).String())
+		// Placement groups are zoned resources, in the same zone as the pool nodes.
+		_ = d.Set("placement_group_id", newZonedID(pool.Zone, *pool.PlacementGroupID).String())
 	}
 
 	return nil
@@ -411,7 +497,21 @@ func resourceScalewayK8SPoolUpdate(ctx context.Context, d *schema.ResourceData,
 	}
 
 	if d.Get("wait_for_pool_ready").(bool) { // wait for the pool to be ready if specified (including all its nodes)
-		err = waitK8SPoolReady(ctx, k8sAPI, region, res.ID)
+		err = waitK8SPoolReady(ctx, meta, k8sAPI, region, res.ID, d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.Get("wait_for_nodes").(bool) {
+		err = waitK8SPoolNodesReady(ctx, k8sAPI, region, res.ID, d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.Get("propagate_tags").(bool) {
+		err = resourceScalewayK8SPoolPropagateTags(ctx, d, meta, k8sAPI, region, res.ID)
 		if err != nil {
 			return diag.FromErr(err)
 		}
@@ -420,6 +520,22 @@ func resourceScalewayK8SPoolUpdate(ctx context.Context, d *schema.ResourceData,
 	return resourceScalewayK8SPoolRead(ctx, d, meta)
 }
 
+// resourceScalewayK8SPoolPropagateTags adds the pool and cluster tags to the servers of the pool nodes.
+func resourceScalewayK8SPoolPropagateTags(ctx context.Context, d *schema.ResourceData, meta interface{}, k8sAPI *k8s.API, region scw.Region, poolID string) error {
+	cluster, err := k8sAPI.GetCluster(&k8s.GetClusterRequest{
+		Region:    region,
+		ClusterID: expandID(d.Get("cluster_id")),
+	}, scw.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+
+	tags := append(expandStrings(d.Get("tags")), cluster.Tags...)
+	instanceAPI := instance.NewAPI(meta.(*Meta).scwClient)
+
+	return k8sPropagatePoolTags(ctx, k8sAPI, instanceAPI, region, poolID, tags)
+}
+
 func resourceScalewayK8SPoolDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	k8sAPI, region, poolID, err := k8sAPIWithRegionAndID(meta, d.Id())
 	if err != nil {
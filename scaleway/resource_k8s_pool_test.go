@@ -165,6 +165,7 @@ func TestAccScalewayK8SCluster_PoolUpgradePolicy(t *testing.T) {
 	defer tt.Cleanup()
 
 	latestK8SVersion := testAccScalewayK8SClusterGetLatestK8SVersion(tt)
+	poolID := ""
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { testAccPreCheck(t) },
@@ -187,6 +188,7 @@ func TestAccScalewayK8SCluster_PoolUpgradePolicy(t *testing.T) {
 					resource.TestCheckResourceAttr("scaleway_k8s_pool.default", "tags.2", "upgrade_policy"),
 					resource.TestCheckResourceAttr("scaleway_k8s_pool.default", "upgrade_policy.0.max_surge", "2"),
 					resource.TestCheckResourceAttr("scaleway_k8s_pool.default", "upgrade_policy.0.max_unavailable", "3"),
+					testAccCheckScalewayK8SPoolSameID("scaleway_k8s_pool.default", &poolID),
 				),
 			},
 			{
@@ -205,6 +207,8 @@ func TestAccScalewayK8SCluster_PoolUpgradePolicy(t *testing.T) {
 					resource.TestCheckResourceAttr("scaleway_k8s_pool.default", "tags.2", "upgrade_policy"),
 					resource.TestCheckResourceAttr("scaleway_k8s_pool.default", "upgrade_policy.0.max_surge", "0"),
 					resource.TestCheckResourceAttr("scaleway_k8s_pool.default", "upgrade_policy.0.max_unavailable", "1"),
+					// the upgrade policy is updated in place
+					testAccCheckScalewayK8SPoolSameID("scaleway_k8s_pool.default", &poolID),
 				),
 			},
 		},
@@ -271,6 +275,24 @@ func TestAccScalewayK8SCluster_PoolZone(t *testing.T) {
 	})
 }
 
+// testAccCheckScalewayK8SPoolSameID stores the pool ID on the first call and checks that it did not change on the next ones.
+func testAccCheckScalewayK8SPoolSameID(n string, id *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", n)
+		}
+		if *id == "" {
+			*id = rs.Primary.ID
+			return nil
+		}
+		if rs.Primary.ID != *id {
+			return fmt.Errorf("pool has been recreated: %s became %s", *id, rs.Primary.ID)
+		}
+		return nil
+	}
+}
+
 func testAccCheckScalewayK8SPoolDestroy(tt *TestTools, n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -146,12 +146,12 @@ func resourceScalewayLbCreate(ctx context.Context, d *schema.ResourceData, meta
 
 	d.SetId(newZonedIDString(zone, res.ID))
 	// wait for lb
-	retryInterval := defaultWaitLBRetryInterval
+	retryInterval := waitRetryInterval(meta, defaultWaitLBRetryInterval)
 	_, err = lbAPI.WaitForLb(&lb.ZonedAPIWaitForLBRequest{
 		Zone:          zone,
 		LBID:          res.ID,
 		Timeout:       scw.TimeDurationPtr(defaultInstanceServerWaitTimeout),
-		RetryInterval: &retryInterval,
+		RetryInterval: retryInterval,
 	}, scw.WithContext(ctx))
 	// check err waiting process
 	if err != nil {
@@ -176,7 +176,7 @@ func resourceScalewayLbCreate(ctx context.Context, d *schema.ResourceData, meta
 			Zone:          zone,
 			LBID:          res.ID,
 			Timeout:       scw.TimeDurationPtr(defaultInstanceServerWaitTimeout),
-			RetryInterval: &retryInterval,
+			RetryInterval: retryInterval,
 		}, scw.WithContext(ctx))
 		if err != nil {
 			return diag.FromErr(err)
@@ -192,12 +192,12 @@ func resourceScalewayLbRead(ctx context.Context, d *schema.ResourceData, meta in
 		return diag.FromErr(err)
 	}
 
-	retryInterval := defaultWaitLBRetryInterval
+	retryInterval := waitRetryInterval(meta, defaultWaitLBRetryInterval)
 	res, err := lbAPI.WaitForLbInstances(&lb.ZonedAPIWaitForLBInstancesRequest{
 		Zone:          zone,
 		LBID:          ID,
 		Timeout:       scw.TimeDurationPtr(defaultInstanceServerWaitTimeout),
-		RetryInterval: &retryInterval,
+		RetryInterval: retryInterval,
 	}, scw.WithContext(ctx))
 	if err != nil {
 		if is404Error(err) || is403Error(err) {
@@ -218,7 +218,7 @@ func resourceScalewayLbRead(ctx context.Context, d *schema.ResourceData, meta in
 	_ = d.Set("region", region.String())
 	_ = d.Set("organization_id", res.OrganizationID)
 	_ = d.Set("project_id", res.ProjectID)
-	_ = d.Set("tags", res.Tags)
+	_ = d.Set("tags", meta.(*Meta).ignoreTags.filter(res.Tags))
 	// For now API return lowercase lb type. This should be fixed in a near future on the API side
 	_ = d.Set("type", strings.ToUpper(res.Type))
 	_ = d.Set("ip_id", newZonedIDString(zone, res.IP[0].ID))
@@ -254,16 +254,19 @@ func resourceScalewayLbUpdate(ctx context.Context, d *schema.ResourceData, meta
 			Tags: expandStrings(d.Get("tags")),
 		}
 
-		_, err = lbAPI.WaitForLb(&lb.ZonedAPIWaitForLBRequest{
+		res, err := lbAPI.WaitForLb(&lb.ZonedAPIWaitForLBRequest{
 			LBID:          ID,
 			Zone:          zone,
 			Timeout:       scw.TimeDurationPtr(lbWaitForTimeout),
-			RetryInterval: DefaultWaitRetryInterval,
+			RetryInterval: waitRetryIntervalOverride(meta),
 		}, scw.WithContext(ctx))
 
 		if err != nil && !is404Error(err) {
 			return diag.FromErr(err)
 		}
+		if res != nil {
+			req.Tags = meta.(*Meta).ignoreTags.merge(req.Tags, res.Tags)
+		}
 
 		_, err = lbAPI.UpdateLB(req, scw.WithContext(ctx))
 		if err != nil && !is404Error(err) {
@@ -274,13 +277,13 @@ func resourceScalewayLbUpdate(ctx context.Context, d *schema.ResourceData, meta
 	// Attach / Detach Private Networks
 	////
 	if d.HasChangesExcept("private_network") {
-		retryInterval := defaultWaitLBRetryInterval
+		retryInterval := waitRetryInterval(meta, defaultWaitLBRetryInterval)
 		// check that pns are in a stable state
 		pns, err := lbAPI.WaitForLBPN(&lb.ZonedAPIWaitForLBPNRequest{
 			Zone:          zone,
 			LBID:          ID,
 			Timeout:       scw.TimeDurationPtr(defaultInstanceServerWaitTimeout),
-			RetryInterval: &retryInterval},
+			RetryInterval: retryInterval},
 			scw.WithContext(ctx))
 		if err != nil && !is404Error(err) {
 			return diag.FromErr(err)
@@ -322,7 +325,7 @@ func resourceScalewayLbUpdate(ctx context.Context, d *schema.ResourceData, meta
 					Zone:          zone,
 					LBID:          ID,
 					Timeout:       scw.TimeDurationPtr(defaultInstanceServerWaitTimeout),
-					RetryInterval: &retryInterval,
+					RetryInterval: retryInterval,
 				}, scw.WithContext(ctx))
 				if err != nil && !is404Error(err) {
 					return diag.FromErr(err)
@@ -338,7 +341,7 @@ func resourceScalewayLbUpdate(ctx context.Context, d *schema.ResourceData, meta
 				Zone:          zone,
 				LBID:          ID,
 				Timeout:       scw.TimeDurationPtr(defaultInstanceServerWaitTimeout),
-				RetryInterval: &retryInterval},
+				RetryInterval: retryInterval},
 				scw.WithContext(ctx))
 			if err != nil && !is404Error(err) {
 				return diag.FromErr(err)
@@ -360,7 +363,7 @@ func resourceScalewayLbDelete(ctx context.Context, d *schema.ResourceData, meta
 		LBID:          ID,
 		Zone:          zone,
 		Timeout:       scw.TimeDurationPtr(lbWaitForTimeout),
-		RetryInterval: scw.TimeDurationPtr(defaultWaitLBRetryInterval),
+		RetryInterval: waitRetryInterval(meta, defaultWaitLBRetryInterval),
 	}, scw.WithContext(ctx))
 	if err != nil {
 		return diag.FromErr(err)
@@ -391,7 +394,7 @@ func resourceScalewayLbDelete(ctx context.Context, d *schema.ResourceData, meta
 			LBID:          ID,
 			Zone:          zone,
 			Timeout:       scw.TimeDurationPtr(lbWaitForTimeout),
-			RetryInterval: scw.TimeDurationPtr(defaultWaitLBRetryInterval),
+			RetryInterval: waitRetryInterval(meta, defaultWaitLBRetryInterval),
 		}, scw.WithContext(ctx))
 		if err != nil && !is404Error(err) {
 			return diag.FromErr(err)
@@ -411,7 +414,7 @@ func resourceScalewayLbDelete(ctx context.Context, d *schema.ResourceData, meta
 		LBID:          ID,
 		Zone:          zone,
 		Timeout:       scw.TimeDurationPtr(lbWaitForTimeout),
-		RetryInterval: scw.TimeDurationPtr(defaultWaitLBRetryInterval),
+		RetryInterval: waitRetryInterval(meta, defaultWaitLBRetryInterval),
 	}, scw.WithContext(ctx))
 	if err != nil && !is404Error(err) {
 		return diag.FromErr(err)
@@ -255,12 +255,12 @@ func resourceScalewayLbBackendCreate(ctx context.Context, d *schema.ResourceData
 		healthCheckPort = d.Get("forward_port").(int)
 	}
 
-	retryInterval := defaultWaitLBRetryInterval
+	retryInterval := waitRetryInterval(meta, defaultWaitLBRetryInterval)
 	_, err = lbAPI.WaitForLb(&lb.ZonedAPIWaitForLBRequest{
 		Zone:          zone,
 		LBID:          LbID,
 		Timeout:       scw.TimeDurationPtr(defaultInstanceServerWaitTimeout),
-		RetryInterval: &retryInterval,
+		RetryInterval: retryInterval,
 	}, scw.WithContext(ctx))
 	if err != nil {
 		if is403Error(err) {
@@ -326,7 +326,7 @@ func resourceScalewayLbBackendCreate(ctx context.Context, d *schema.ResourceData
 		Zone:          zone,
 		LBID:          res.LB.ID,
 		Timeout:       scw.TimeDurationPtr(defaultInstanceServerWaitTimeout),
-		RetryInterval: &retryInterval,
+		RetryInterval: retryInterval,
 	}, scw.WithContext(ctx))
 	if err != nil {
 		if is403Error(err) {
@@ -382,12 +382,12 @@ func resourceScalewayLbBackendRead(ctx context.Context, d *schema.ResourceData,
 	_ = d.Set("health_check_http", flattenLbHCHTTP(res.HealthCheck.HTTPConfig))
 	_ = d.Set("health_check_https", flattenLbHCHTTPS(res.HealthCheck.HTTPSConfig))
 
-	retryInterval := defaultWaitLBRetryInterval
+	retryInterval := waitRetryInterval(meta, defaultWaitLBRetryInterval)
 	_, err = lbAPI.WaitForLb(&lb.ZonedAPIWaitForLBRequest{
 		Zone:          zone,
 		LBID:          res.LB.ID,
 		Timeout:       scw.TimeDurationPtr(defaultInstanceServerWaitTimeout),
-		RetryInterval: &retryInterval,
+		RetryInterval: retryInterval,
 	}, scw.WithContext(ctx))
 	if err != nil {
 		if is403Error(err) {
@@ -411,12 +411,12 @@ func resourceScalewayLbBackendUpdate(ctx context.Context, d *schema.ResourceData
 		return diag.FromErr(err)
 	}
 
-	retryInterval := defaultWaitLBRetryInterval
+	retryInterval := waitRetryInterval(meta, defaultWaitLBRetryInterval)
 	_, err = lbAPI.WaitForLb(&lb.ZonedAPIWaitForLBRequest{
 		Zone:          zone,
 		LBID:          LbID,
 		Timeout:       scw.TimeDurationPtr(defaultInstanceServerWaitTimeout),
-		RetryInterval: &retryInterval,
+		RetryInterval: retryInterval,
 	}, scw.WithContext(ctx))
 	if err != nil {
 		if is403Error(err) {
@@ -505,7 +505,7 @@ func resourceScalewayLbBackendUpdate(ctx context.Context, d *schema.ResourceData
 		Zone:          zone,
 		LBID:          LbID,
 		Timeout:       scw.TimeDurationPtr(defaultInstanceServerWaitTimeout),
-		RetryInterval: &retryInterval,
+		RetryInterval: retryInterval,
 	}, scw.WithContext(ctx))
 	if err != nil {
 		if is403Error(err) {
@@ -529,12 +529,12 @@ func resourceScalewayLbBackendDelete(ctx context.Context, d *schema.ResourceData
 		return diag.FromErr(err)
 	}
 
-	retryInterval := defaultWaitLBRetryInterval
+	retryInterval := waitRetryInterval(meta, defaultWaitLBRetryInterval)
 	_, err = lbAPI.WaitForLb(&lb.ZonedAPIWaitForLBRequest{
 		Zone:          zone,
 		LBID:          LbID,
 		Timeout:       scw.TimeDurationPtr(defaultInstanceServerWaitTimeout),
-		RetryInterval: &retryInterval,
+		RetryInterval: retryInterval,
 	}, scw.WithContext(ctx))
 	if err != nil {
 		if is403Error(err) {
@@ -557,7 +557,7 @@ func resourceScalewayLbBackendDelete(ctx context.Context, d *schema.ResourceData
 		Zone:          zone,
 		LBID:          LbID,
 		Timeout:       scw.TimeDurationPtr(defaultInstanceServerWaitTimeout),
-		RetryInterval: &retryInterval,
+		RetryInterval: retryInterval,
 	}, scw.WithContext(ctx))
 	if err != nil {
 		if is403Error(err) {
@@ -17,6 +17,9 @@ func resourceScalewayLbCertificate() *schema.Resource {
 		ReadContext:   resourceScalewayLbCertificateRead,
 		UpdateContext: resourceScalewayLbCertificateUpdate,
 		DeleteContext: resourceScalewayLbCertificateDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
 		SchemaVersion: 1,
 		Timeouts: &schema.ResourceTimeout{
 			Default: schema.DefaultTimeout(defaultLbLbTimeout),
@@ -141,12 +144,12 @@ func resourceScalewayLbCertificateCreate(ctx context.Context, d *schema.Resource
 		return diag.FromErr(errors.New("you need to define either letsencrypt or custom_certificate configuration"))
 	}
 
-	retryInterval := defaultWaitLBRetryInterval
+	retryInterval := waitRetryInterval(meta, defaultWaitLBRetryInterval)
 	_, err = lbAPI.WaitForLb(&lb.ZonedAPIWaitForLBRequest{
 		Zone:          zone,
 		LBID:          lbID,
 		Timeout:       scw.TimeDurationPtr(defaultInstanceServerWaitTimeout),
-		RetryInterval: &retryInterval,
+		RetryInterval: retryInterval,
 	}, scw.WithContext(ctx))
 	if err != nil {
 		if is403Error(err) {
@@ -165,7 +168,7 @@ func resourceScalewayLbCertificateCreate(ctx context.Context, d *schema.Resource
 		CertID:        res.ID,
 		Zone:          res.LB.Zone,
 		Timeout:       scw.TimeDurationPtr(defaultLbLbTimeout),
-		RetryInterval: scw.TimeDurationPtr(defaultWaitLBRetryInterval),
+		RetryInterval: waitRetryInterval(meta, defaultWaitLBRetryInterval),
 	})
 	if err != nil {
 		return diag.FromErr(err)
@@ -175,7 +178,7 @@ func resourceScalewayLbCertificateCreate(ctx context.Context, d *schema.Resource
 		Zone:          zone,
 		LBID:          lbID,
 		Timeout:       scw.TimeDurationPtr(defaultInstanceServerWaitTimeout),
-		RetryInterval: &retryInterval,
+		RetryInterval: retryInterval,
 	}, scw.WithContext(ctx))
 	if err != nil {
 		if is403Error(err) {
@@ -200,7 +203,7 @@ func resourceScalewayLbCertificateRead(ctx context.Context, d *schema.ResourceDa
 		CertID:        ID,
 		Zone:          zone,
 		Timeout:       scw.TimeDurationPtr(defaultLbLbTimeout),
-		RetryInterval: scw.TimeDurationPtr(defaultWaitLBRetryInterval),
+		RetryInterval: waitRetryInterval(meta, defaultWaitLBRetryInterval),
 	})
 	if err != nil {
 		return diag.FromErr(err)
@@ -211,12 +214,12 @@ func resourceScalewayLbCertificateRead(ctx context.Context, d *schema.ResourceDa
 		return diag.FromErr(fmt.Errorf("certificate with error state"))
 	}
 
-	retryInterval := defaultWaitLBRetryInterval
+	retryInterval := waitRetryInterval(meta, defaultWaitLBRetryInterval)
 	_, err = lbAPI.WaitForLb(&lb.ZonedAPIWaitForLBRequest{
 		Zone:          zone,
 		LBID:          cert.LB.ID,
 		Timeout:       scw.TimeDurationPtr(defaultInstanceServerWaitTimeout),
-		RetryInterval: &retryInterval,
+		RetryInterval: retryInterval,
 	}, scw.WithContext(ctx))
 	if err != nil {
 		return diag.FromErr(err)
@@ -230,6 +233,16 @@ func resourceScalewayLbCertificateRead(ctx context.Context, d *schema.ResourceDa
 	_ = d.Set("not_valid_before", flattenTime(cert.NotValidBefore))
 	_ = d.Set("not_valid_after", flattenTime(cert.NotValidAfter))
 	_ = d.Set("status", cert.Status)
+
+	// letsencrypt configuration is not in the state after an import
+	_, hasLetsencrypt := d.GetOk("letsencrypt")
+	_, hasCustomCertificate := d.GetOk("custom_certificate")
+	if !hasLetsencrypt && !hasCustomCertificate && cert.Type != lb.CertificateTypeCustom {
+		_ = d.Set("letsencrypt", []map[string]interface{}{{
+			"common_name":              cert.CommonName,
+			"subject_alternative_name": cert.SubjectAlternativeName,
+		}})
+	}
 	return nil
 }
 
@@ -243,18 +256,18 @@ func resourceScalewayLbCertificateUpdate(ctx context.Context, d *schema.Resource
 		CertID:        ID,
 		Zone:          zone,
 		Timeout:       scw.TimeDurationPtr(defaultLbLbTimeout),
-		RetryInterval: scw.TimeDurationPtr(defaultWaitLBRetryInterval),
+		RetryInterval: waitRetryInterval(meta, defaultWaitLBRetryInterval),
 	})
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	retryInterval := defaultWaitLBRetryInterval
+	retryInterval := waitRetryInterval(meta, defaultWaitLBRetryInterval)
 	_, err = lbAPI.WaitForLb(&lb.ZonedAPIWaitForLBRequest{
 		Zone:          zone,
 		LBID:          cert.LB.ID,
 		Timeout:       scw.TimeDurationPtr(defaultInstanceServerWaitTimeout),
-		RetryInterval: &retryInterval,
+		RetryInterval: retryInterval,
 	}, scw.WithContext(ctx))
 	if err != nil {
 		if is403Error(err) {
@@ -280,7 +293,7 @@ func resourceScalewayLbCertificateUpdate(ctx context.Context, d *schema.Resource
 			Zone:          zone,
 			LBID:          cert.LB.ID,
 			Timeout:       scw.TimeDurationPtr(defaultInstanceServerWaitTimeout),
-			RetryInterval: &retryInterval,
+			RetryInterval: retryInterval,
 		}, scw.WithContext(ctx))
 		if err != nil {
 			if is403Error(err) {
@@ -304,18 +317,18 @@ func resourceScalewayLbCertificateDelete(ctx context.Context, d *schema.Resource
 		CertID:        ID,
 		Zone:          zone,
 		Timeout:       scw.TimeDurationPtr(defaultLbLbTimeout),
-		RetryInterval: scw.TimeDurationPtr(defaultWaitLBRetryInterval),
+		RetryInterval: waitRetryInterval(meta, defaultWaitLBRetryInterval),
 	})
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	retryInterval := defaultWaitLBRetryInterval
+	retryInterval := waitRetryInterval(meta, defaultWaitLBRetryInterval)
 	_, err = lbAPI.WaitForLb(&lb.ZonedAPIWaitForLBRequest{
 		Zone:          zone,
 		LBID:          cert.LB.ID,
 		Timeout:       scw.TimeDurationPtr(defaultInstanceServerWaitTimeout),
-		RetryInterval: &retryInterval,
+		RetryInterval: retryInterval,
 	}, scw.WithContext(ctx))
 	if err != nil {
 		if is403Error(err) {
@@ -337,7 +350,7 @@ func resourceScalewayLbCertificateDelete(ctx context.Context, d *schema.Resource
 		Zone:          zone,
 		LBID:          cert.LB.ID,
 		Timeout:       scw.TimeDurationPtr(defaultInstanceServerWaitTimeout),
-		RetryInterval: &retryInterval,
+		RetryInterval: retryInterval,
 	}, scw.WithContext(ctx))
 	if err != nil {
 		if is403Error(err) {
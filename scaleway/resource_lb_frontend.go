@@ -20,7 +20,7 @@ func resourceScalewayLbFrontend() *schema.Resource {
 		UpdateContext: resourceScalewayLbFrontendUpdate,
 		DeleteContext: resourceScalewayLbFrontendDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: resourceScalewayLbFrontendImport,
 		},
 		Timeouts: &schema.ResourceTimeout{
 			Default: schema.DefaultTimeout(defaultLbLbTimeout),
@@ -163,12 +163,12 @@ func resourceScalewayLbFrontendCreate(ctx context.Context, d *schema.ResourceDat
 		return diag.FromErr(err)
 	}
 
-	retryInterval := defaultWaitLBRetryInterval
+	retryInterval := waitRetryInterval(meta, defaultWaitLBRetryInterval)
 	_, err = lbAPI.WaitForLb(&lb.ZonedAPIWaitForLBRequest{
 		Zone:          zone,
 		LBID:          lbID,
 		Timeout:       scw.TimeDurationPtr(defaultInstanceServerWaitTimeout),
-		RetryInterval: &retryInterval,
+		RetryInterval: retryInterval,
 	}, scw.WithContext(ctx))
 	if err != nil {
 		if is403Error(err) {
@@ -205,6 +205,26 @@ func resourceScalewayLbFrontendCreate(ctx context.Context, d *schema.ResourceDat
 	return resourceScalewayLbFrontendRead(ctx, d, meta)
 }
 
+func resourceScalewayLbFrontendImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	lbAPI, zone, ID, err := lbAPIWithZoneAndID(meta, d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := lbAPI.GetFrontend(&lb.ZonedAPIGetFrontendRequest{
+		Zone:       zone,
+		FrontendID: ID,
+	}, scw.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	// The read waits for the lb of the frontend, which is not in the state yet
+	_ = d.Set("lb_id", newZonedIDString(zone, res.LB.ID))
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceScalewayLbFrontendRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	lbAPI, zone, ID, err := lbAPIWithZoneAndID(meta, d.Id())
 	if err != nil {
@@ -216,12 +236,12 @@ func resourceScalewayLbFrontendRead(ctx context.Context, d *schema.ResourceData,
 		return diag.FromErr(err)
 	}
 
-	retryInterval := defaultWaitLBRetryInterval
+	retryInterval := waitRetryInterval(meta, defaultWaitLBRetryInterval)
 	_, err = lbAPI.WaitForLb(&lb.ZonedAPIWaitForLBRequest{
 		Zone:          zone,
 		LBID:          lbID,
 		Timeout:       scw.TimeDurationPtr(defaultInstanceServerWaitTimeout),
-		RetryInterval: &retryInterval,
+		RetryInterval: retryInterval,
 	}, scw.WithContext(ctx))
 	if err != nil {
 		if is403Error(err) {
@@ -366,7 +386,7 @@ func resourceScalewayLbFrontendUpdate(ctx context.Context, d *schema.ResourceDat
 		return diag.FromErr(err)
 	}
 
-	retryInterval := defaultWaitLBRetryInterval
+	retryInterval := waitRetryInterval(meta, defaultWaitLBRetryInterval)
 	_, lbID, err := parseZonedID(d.Get("lb_id").(string))
 	if err != nil {
 		return diag.FromErr(err)
@@ -375,7 +395,7 @@ func resourceScalewayLbFrontendUpdate(ctx context.Context, d *schema.ResourceDat
 		Zone:          zone,
 		LBID:          lbID,
 		Timeout:       scw.TimeDurationPtr(defaultInstanceServerWaitTimeout),
-		RetryInterval: &retryInterval,
+		RetryInterval: retryInterval,
 	}, scw.WithContext(ctx))
 	// check err waiting process
 	if err != nil {
@@ -116,7 +116,7 @@ func resourceScalewayLbIPRead(ctx context.Context, d *schema.ResourceData, meta
 			Zone:          zone,
 			LBID:          *ip.LBID,
 			Timeout:       scw.TimeDurationPtr(defaultInstanceServerWaitTimeout),
-			RetryInterval: scw.TimeDurationPtr(defaultWaitLBRetryInterval),
+			RetryInterval: waitRetryInterval(meta, defaultWaitLBRetryInterval),
 		}, scw.WithContext(ctx))
 		if err != nil {
 			if is403Error(err) {
@@ -180,7 +180,7 @@ func resourceScalewayLbIPUpdate(ctx context.Context, d *schema.ResourceData, met
 			Zone:          zone,
 			LBID:          *ip.LBID,
 			Timeout:       scw.TimeDurationPtr(defaultInstanceServerWaitTimeout),
-			RetryInterval: scw.TimeDurationPtr(defaultWaitLBRetryInterval),
+			RetryInterval: waitRetryInterval(meta, defaultWaitLBRetryInterval),
 		}, scw.WithContext(ctx))
 		if err != nil {
 			if is403Error(err) {
@@ -209,7 +209,7 @@ func resourceScalewayLbIPUpdate(ctx context.Context, d *schema.ResourceData, met
 			Zone:          zone,
 			LBID:          *ip.LBID,
 			Timeout:       scw.TimeDurationPtr(defaultInstanceServerWaitTimeout),
-			RetryInterval: scw.TimeDurationPtr(defaultWaitLBRetryInterval),
+			RetryInterval: waitRetryInterval(meta, defaultWaitLBRetryInterval),
 		}, scw.WithContext(ctx))
 		if err != nil {
 			if is403Error(err) {
@@ -256,7 +256,7 @@ func resourceScalewayLbIPDelete(ctx context.Context, d *schema.ResourceData, met
 			LBID:          *ip.LBID,
 			Zone:          zone,
 			Timeout:       scw.TimeDurationPtr(lbWaitForTimeout),
-			RetryInterval: scw.TimeDurationPtr(defaultWaitLBRetryInterval),
+			RetryInterval: waitRetryInterval(meta, defaultWaitLBRetryInterval),
 		}, scw.WithContext(ctx))
 		if err != nil {
 			if is403Error(err) {
@@ -282,7 +282,7 @@ func resourceScalewayLbIPDelete(ctx context.Context, d *schema.ResourceData, met
 			LBID:          *ip.LBID,
 			Zone:          zone,
 			Timeout:       scw.TimeDurationPtr(lbWaitForTimeout),
-			RetryInterval: scw.TimeDurationPtr(defaultWaitLBRetryInterval),
+			RetryInterval: waitRetryInterval(meta, defaultWaitLBRetryInterval),
 		}, scw.WithContext(ctx))
 		if err != nil {
 			if is404Error(err) || is403Error(err) {
@@ -153,7 +153,7 @@ func testAccCheckScalewayLbIPDestroy(tt *TestTools) resource.TestCheckFunc {
 					Zone:          zone,
 					LBID:          lbID,
 					Timeout:       scw.TimeDurationPtr(defaultInstanceServerWaitTimeout),
-					RetryInterval: scw.TimeDurationPtr(defaultWaitLBRetryInterval),
+					RetryInterval: waitRetryInterval(tt.Meta, defaultWaitLBRetryInterval),
 				})
 				// Unexpected api error we return it
 				if !is404Error(err) {
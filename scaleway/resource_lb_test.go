@@ -36,7 +36,7 @@ func testSweepLB(_ string) error {
 				LBID:          l.ID,
 				Zone:          zone,
 				Timeout:       scw.TimeDurationPtr(lbWaitForTimeout),
-				RetryInterval: scw.TimeDurationPtr(defaultWaitLBRetryInterval),
+				RetryInterval: waitRetryInterval(nil, defaultWaitLBRetryInterval),
 			})
 			if err != nil {
 				return fmt.Errorf("error waiting for lb in sweeper: %s", err)
@@ -24,6 +24,10 @@ func resourceScalewayObjectBucket() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			{Version: 0, Type: objectBucketUpgradeV1SchemaType(), Upgrade: objectBucketUpgradeV1SchemaUpgradeFunc},
+		},
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:        schema.TypeString,
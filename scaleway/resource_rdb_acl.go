@@ -64,7 +64,7 @@ func resourceScalewayRdbACLCreate(ctx context.Context, d *schema.ResourceData, m
 		return diag.FromErr(err)
 	}
 
-	_, err = waitInstance(ctx, rdbAPI, region, expandID(instanceID))
+	_, err = waitInstance(ctx, meta, rdbAPI, region, expandID(instanceID))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -95,7 +95,7 @@ func resourceScalewayRdbACLRead(ctx context.Context, d *schema.ResourceData, met
 		return diag.FromErr(err)
 	}
 
-	_, err = waitInstance(ctx, rdbAPI, region, instanceID)
+	_, err = waitInstance(ctx, meta, rdbAPI, region, instanceID)
 	if err != nil && !is404Error(err) {
 		return diag.FromErr(err)
 	}
@@ -127,7 +127,7 @@ func resourceScalewayRdbACLUpdate(ctx context.Context, d *schema.ResourceData, m
 		return diag.FromErr(err)
 	}
 
-	_, err = waitInstance(ctx, rdbAPI, region, instanceID)
+	_, err = waitInstance(ctx, meta, rdbAPI, region, instanceID)
 	if err != nil && !is404Error(err) {
 		return diag.FromErr(err)
 	}
@@ -171,7 +171,7 @@ func resourceScalewayRdbACLDelete(ctx context.Context, d *schema.ResourceData, m
 		aclRuleIPs = append(aclRuleIPs, acl.IP.String())
 	}
 
-	_, err = waitInstance(ctx, rdbAPI, region, instanceID)
+	_, err = waitInstance(ctx, meta, rdbAPI, region, instanceID)
 	if err != nil && !is404Error(err) {
 		return diag.FromErr(err)
 	}
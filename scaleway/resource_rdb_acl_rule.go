@@ -0,0 +1,210 @@
+package scaleway
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/scaleway/scaleway-sdk-go/api/rdb/v1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+)
+
+func resourceScalewayRdbACLRule() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceScalewayRdbACLRuleCreate,
+		ReadContext:   resourceScalewayRdbACLRuleRead,
+		DeleteContext: resourceScalewayRdbACLRuleDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Default: schema.DefaultTimeout(defaultRdbInstanceTimeout),
+		},
+		SchemaVersion: 0,
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateFunc:     validationUUIDorUUIDWithLocality(),
+				DiffSuppressFunc: diffSuppressFuncLocality,
+				Description:      "Instance on which the ACL rule is applied",
+			},
+			"ip": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateFunc:     validation.IsCIDR,
+				DiffSuppressFunc: diffSuppressFuncRdbACLRuleIP,
+				Description:      "Target IP of the rule",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "Description of the rule",
+			},
+			// Common
+			"region": regionSchema(),
+		},
+	}
+}
+
+func resourceScalewayRdbACLRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	rdbAPI, region, instanceID, err := rdbAPIWithRegionAndID(meta, d.Get("instance_id").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	ip, err := expandIPNet(d.Get("ip").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Rules of the same instance are added one after the other, see rdbInstanceLocks
+	unlock := rdbInstanceLocks.lock(instanceID)
+	defer unlock()
+
+	_, err = waitInstance(ctx, meta, rdbAPI, region, instanceID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	res, err := rdbAPI.AddInstanceACLRules(&rdb.AddInstanceACLRulesRequest{
+		Region:     region,
+		InstanceID: instanceID,
+		Rules: []*rdb.ACLRuleRequest{
+			{
+				IP:          ip,
+				Description: d.Get("description").(string),
+			},
+		},
+	}, scw.WithContext(ctx))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_, err = waitInstance(ctx, meta, rdbAPI, region, instanceID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// The API returns the IP of the rule in its canonical form
+	ruleIP := ip.String()
+	if len(res.Rules) > 0 {
+		ruleIP = res.Rules[0].IP.String()
+	}
+	d.SetId(resourceScalewayRdbACLRuleID(region, instanceID, ruleIP))
+
+	return resourceScalewayRdbACLRuleRead(ctx, d, meta)
+}
+
+func resourceScalewayRdbACLRuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	rdbAPI := newRdbAPI(meta)
+	region, instanceID, ip, err := resourceScalewayRdbACLRuleParseID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	res, err := rdbAPI.ListInstanceACLRules(&rdb.ListInstanceACLRulesRequest{
+		Region:     region,
+		InstanceID: instanceID,
+	}, scw.WithAllPages(), scw.WithContext(ctx))
+	if err != nil {
+		if is404Error(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	var rule *rdb.ACLRule
+	for _, r := range res.Rules {
+		if rdbACLRuleIPEqual(r.IP.String(), ip) {
+			rule = r
+			break
+		}
+	}
+	// The rule has been removed outside of terraform
+	if rule == nil {
+		d.SetId("")
+		return nil
+	}
+
+	_ = d.Set("instance_id", newRegionalIDString(region, instanceID))
+	_ = d.Set("ip", rule.IP.String())
+	_ = d.Set("description", rule.Description)
+	_ = d.Set("region", string(region))
+
+	return nil
+}
+
+func resourceScalewayRdbACLRuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	rdbAPI := newRdbAPI(meta)
+	region, instanceID, ip, err := resourceScalewayRdbACLRuleParseID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	unlock := rdbInstanceLocks.lock(instanceID)
+	defer unlock()
+
+	_, err = waitInstance(ctx, meta, rdbAPI, region, instanceID)
+	if err != nil {
+		if is404Error(err) {
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	_, err = rdbAPI.DeleteInstanceACLRules(&rdb.DeleteInstanceACLRulesRequest{
+		Region:     region,
+		InstanceID: instanceID,
+		ACLRuleIPs: []string{ip},
+	}, scw.WithContext(ctx))
+	if err != nil && !is404Error(err) {
+		return diag.FromErr(err)
+	}
+
+	_, err = waitInstance(ctx, meta, rdbAPI, region, instanceID)
+	if err != nil && !is404Error(err) {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// Build the resource identifier
+// The resource identifier format is "Region/InstanceId/IP", the IP is in CIDR notation
+func resourceScalewayRdbACLRuleID(region scw.Region, instanceID string, ip string) (resourceID string) {
+	return fmt.Sprintf("%s/%s/%s", region, instanceID, ip)
+}
+
+// Extract instance ID and IP from the resource identifier.
+// The resource identifier format is "Region/InstanceId/IP"
+func resourceScalewayRdbACLRuleParseID(resourceID string) (region scw.Region, instanceID string, ip string, err error) {
+	idParts := strings.SplitN(resourceID, "/", 3)
+	if len(idParts) != 3 {
+		return "", "", "", fmt.Errorf("can't parse acl rule resource id: %s", resourceID)
+	}
+	return scw.Region(idParts[0]), idParts[1], idParts[2], nil
+}
+
+// rdbACLRuleIPEqual returns true when both CIDRs cover the same network, e.g. 1.2.3.4/24 and 1.2.3.0/24
+func rdbACLRuleIPEqual(a, b string) bool {
+	_, networkA, errA := net.ParseCIDR(a)
+	_, networkB, errB := net.ParseCIDR(b)
+	if errA != nil || errB != nil {
+		return a == b
+	}
+	return networkA.String() == networkB.String()
+}
+
+func diffSuppressFuncRdbACLRuleIP(_, oldValue, newValue string, _ *schema.ResourceData) bool {
+	return rdbACLRuleIPEqual(oldValue, newValue)
+}
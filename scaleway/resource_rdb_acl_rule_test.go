@@ -0,0 +1,27 @@
+package scaleway
+
+import (
+	"testing"
+
+	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRdbACLRuleParseID(t *testing.T) {
+	region, instanceID, ip, err := resourceScalewayRdbACLRuleParseID("fr-par/11111111-1111-1111-1111-111111111111/1.2.3.0/24")
+	assert.NoError(t, err)
+	assert.Equal(t, scw.RegionFrPar, region)
+	assert.Equal(t, "11111111-1111-1111-1111-111111111111", instanceID)
+	assert.Equal(t, "1.2.3.0/24", ip)
+
+	_, _, _, err = resourceScalewayRdbACLRuleParseID("fr-par/11111111-1111-1111-1111-111111111111")
+	assert.Error(t, err)
+}
+
+func TestRdbACLRuleIPEqual(t *testing.T) {
+	assert.True(t, rdbACLRuleIPEqual("1.2.3.4/32", "1.2.3.4/32"))
+	assert.True(t, rdbACLRuleIPEqual("1.2.3.4/24", "1.2.3.0/24"))
+	assert.False(t, rdbACLRuleIPEqual("1.2.3.4/32", "1.2.3.5/32"))
+	assert.False(t, rdbACLRuleIPEqual("1.2.3.0/24", "1.2.3.0/16"))
+	assert.False(t, rdbACLRuleIPEqual("1.2.3.4/32", ""))
+}
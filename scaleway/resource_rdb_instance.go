@@ -15,6 +15,8 @@ import (
 
 const (
 	defaultWaitRDBRetryInterval = 30 * time.Second
+
+	rdbInstanceBackupDisabledWarning = "automated backups are disabled, the data of the instance cannot be restored from a scheduled backup"
 )
 
 func resourceScalewayRdbInstance() *schema.Resource {
@@ -23,6 +25,7 @@ func resourceScalewayRdbInstance() *schema.Resource {
 		ReadContext:   resourceScalewayRdbInstanceRead,
 		UpdateContext: resourceScalewayRdbInstanceUpdate,
 		DeleteContext: resourceScalewayRdbInstanceDelete,
+		CustomizeDiff: customizeDiffRdbInstanceBackup,
 		Timeouts: &schema.ResourceTimeout{
 			Default: schema.DefaultTimeout(defaultRdbInstanceTimeout),
 		},
@@ -62,16 +65,18 @@ func resourceScalewayRdbInstance() *schema.Resource {
 				Description: "Disable automated backup for the database instance",
 			},
 			"backup_schedule_frequency": {
-				Type:        schema.TypeInt,
-				Optional:    true,
-				Computed:    true,
-				Description: "Backup schedule frequency in hours",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				Description:  "Backup schedule frequency in hours",
+				ValidateFunc: validation.IntAtLeast(1),
 			},
 			"backup_schedule_retention": {
-				Type:        schema.TypeInt,
-				Optional:    true,
-				Computed:    true,
-				Description: "Backup schedule retention in days",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				Description:  "Backup schedule retention in days",
+				ValidateFunc: validation.IntAtLeast(1),
 			},
 			"user_name": {
 				Type:        schema.TypeString,
@@ -323,7 +328,7 @@ func resourceScalewayRdbInstanceCreate(ctx context.Context, d *schema.ResourceDa
 			updateReq.BackupScheduleRetention = scw.Uint32Ptr(uint32(backupScheduleRetention.(int)))
 		}
 
-		_, err = waitInstance(ctx, rdbAPI, region, res.ID)
+		_, err = waitInstance(ctx, meta, rdbAPI, region, res.ID)
 		if err != nil {
 			return diag.FromErr(err)
 		}
@@ -335,7 +340,7 @@ func resourceScalewayRdbInstanceCreate(ctx context.Context, d *schema.ResourceDa
 	}
 	// Configure Instance settings
 	if settings, ok := d.GetOk("settings"); ok {
-		res, err = waitInstance(ctx, rdbAPI, region, res.ID)
+		res, err = waitInstance(ctx, meta, rdbAPI, region, res.ID)
 		if err != nil {
 			return diag.FromErr(err)
 		}
@@ -350,7 +355,15 @@ func resourceScalewayRdbInstanceCreate(ctx context.Context, d *schema.ResourceDa
 		}
 	}
 
-	return resourceScalewayRdbInstanceRead(ctx, d, meta)
+	var warnings diag.Diagnostics
+	if d.Get("disable_backup").(bool) {
+		warnings = append(warnings, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  rdbInstanceBackupDisabledWarning,
+		})
+	}
+
+	return append(warnings, resourceScalewayRdbInstanceRead(ctx, d, meta)...)
 }
 
 func resourceScalewayRdbInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -360,7 +373,7 @@ func resourceScalewayRdbInstanceRead(ctx context.Context, d *schema.ResourceData
 	}
 
 	// verify resource is ready
-	res, err := waitInstance(ctx, rdbAPI, region, ID)
+	res, err := waitInstance(ctx, meta, rdbAPI, region, ID)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -432,8 +445,15 @@ func resourceScalewayRdbInstanceUpdate(ctx context.Context, d *schema.ResourceDa
 	if d.HasChange("name") {
 		req.Name = expandStringPtr(d.Get("name"))
 	}
+	var warnings diag.Diagnostics
 	if d.HasChange("disable_backup") {
 		req.IsBackupScheduleDisabled = scw.BoolPtr(d.Get("disable_backup").(bool))
+		if d.Get("disable_backup").(bool) {
+			warnings = append(warnings, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  rdbInstanceBackupDisabledWarning,
+			})
+		}
 	}
 	if d.HasChange("backup_schedule_frequency") {
 		req.BackupScheduleFrequency = scw.Uint32Ptr(uint32(d.Get("backup_schedule_frequency").(int)))
@@ -445,7 +465,7 @@ func resourceScalewayRdbInstanceUpdate(ctx context.Context, d *schema.ResourceDa
 		req.Tags = scw.StringsPtr(expandStrings(d.Get("tags")))
 	}
 
-	_, err = waitInstance(ctx, rdbAPI, region, ID)
+	_, err = waitInstance(ctx, meta, rdbAPI, region, ID)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -456,7 +476,7 @@ func resourceScalewayRdbInstanceUpdate(ctx context.Context, d *schema.ResourceDa
 	}
 	// Change settings
 	if d.HasChange("settings") {
-		_, err = waitInstance(ctx, rdbAPI, region, ID)
+		_, err = waitInstance(ctx, meta, rdbAPI, region, ID)
 		if err != nil && !is404Error(err) {
 			return diag.FromErr(err)
 		}
@@ -539,7 +559,7 @@ func resourceScalewayRdbInstanceUpdate(ctx context.Context, d *schema.ResourceDa
 			})
 	}
 	for _, request := range upgradeInstanceRequests {
-		_, err = waitInstance(ctx, rdbAPI, region, ID)
+		_, err = waitInstance(ctx, meta, rdbAPI, region, ID)
 		if err != nil && !is404Error(err) {
 			return diag.FromErr(err)
 		}
@@ -549,14 +569,14 @@ func resourceScalewayRdbInstanceUpdate(ctx context.Context, d *schema.ResourceDa
 			return diag.FromErr(err)
 		}
 
-		_, err = waitInstance(ctx, rdbAPI, region, ID)
+		_, err = waitInstance(ctx, meta, rdbAPI, region, ID)
 		if err != nil && !is404Error(err) {
 			return diag.FromErr(err)
 		}
 	}
 
 	if d.HasChange("password") {
-		_, err := waitInstance(ctx, rdbAPI, region, ID)
+		_, err := waitInstance(ctx, meta, rdbAPI, region, ID)
 		if err != nil {
 			return diag.FromErr(err)
 		}
@@ -576,7 +596,7 @@ func resourceScalewayRdbInstanceUpdate(ctx context.Context, d *schema.ResourceDa
 
 	if d.HasChanges("private_network") {
 		// retrieve state
-		res, err := waitInstance(ctx, rdbAPI, region, ID)
+		res, err := waitInstance(ctx, meta, rdbAPI, region, ID)
 		if err != nil {
 			return diag.FromErr(err)
 		}
@@ -584,7 +604,7 @@ func resourceScalewayRdbInstanceUpdate(ctx context.Context, d *schema.ResourceDa
 		// get endpoints to detach. It will handle only private networks
 		endPointsToRemove, err := endpointsToRemove(res.Endpoints, d.Get("private_network"))
 		if err != nil {
-			diag.FromErr(err)
+			return diag.FromErr(err)
 		}
 		for endPointID, remove := range endPointsToRemove {
 			if remove {
@@ -592,19 +612,19 @@ func resourceScalewayRdbInstanceUpdate(ctx context.Context, d *schema.ResourceDa
 					&rdb.DeleteEndpointRequest{
 						EndpointID: endPointID, Region: region},
 					scw.WithContext(ctx))
-				if err != nil {
-					diag.FromErr(err)
+				if err != nil && !is404Error(err) {
+					return diag.FromErr(err)
 				}
 			}
 		}
 
 		// retrieve state
-		_, err = waitInstance(ctx, rdbAPI, region, ID)
+		res, err = waitInstance(ctx, meta, rdbAPI, region, ID)
 		if err != nil {
 			return diag.FromErr(err)
 		}
 
-		// set new endpoints
+		// set new endpoints, the unchanged ones are kept as is
 		pn, pnExist := d.GetOk("private_network")
 		if pnExist {
 			privateEndpoints, err := expandPrivateNetwork(pn, pnExist)
@@ -612,17 +632,20 @@ func resourceScalewayRdbInstanceUpdate(ctx context.Context, d *schema.ResourceDa
 				return diag.FromErr(err)
 			}
 			for _, e := range privateEndpoints {
+				if rdbPrivateNetworkEndpointExists(res.Endpoints, e.PrivateNetwork) {
+					continue
+				}
 				_, err := rdbAPI.CreateEndpoint(
 					&rdb.CreateEndpointRequest{Region: region, InstanceID: ID, EndpointSpec: e},
 					scw.WithContext(ctx))
 				if err != nil {
-					diag.FromErr(err)
+					return diag.FromErr(err)
 				}
 			}
 		}
 	}
 
-	return resourceScalewayRdbInstanceRead(ctx, d, meta)
+	return append(warnings, resourceScalewayRdbInstanceRead(ctx, d, meta)...)
 }
 
 func resourceScalewayRdbInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -632,7 +655,7 @@ func resourceScalewayRdbInstanceDelete(ctx context.Context, d *schema.ResourceDa
 	}
 
 	// We first wait in case the instance is in a transient state
-	_, err = waitInstance(ctx, rdbAPI, region, ID)
+	_, err = waitInstance(ctx, meta, rdbAPI, region, ID)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -647,10 +670,22 @@ func resourceScalewayRdbInstanceDelete(ctx context.Context, d *schema.ResourceDa
 	}
 
 	// Lastly wait in case the instance is in a transient state
-	_, err = waitInstance(ctx, rdbAPI, region, ID)
+	_, err = waitInstance(ctx, meta, rdbAPI, region, ID)
 	if err != nil && !is404Error(err) {
 		return diag.FromErr(err)
 	}
 
 	return nil
 }
+
+// customizeDiffRdbInstanceBackup logs at plan time when automated backups are being disabled,
+// CustomizeDiff cannot return warnings, they are returned by Create and Update.
+func customizeDiffRdbInstanceBackup(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	if !diff.Get("disable_backup").(bool) {
+		return nil
+	}
+	if diff.Id() == "" || diff.HasChange("disable_backup") {
+		l.Warningf("rdb instance %s: %s", diff.Get("name"), rdbInstanceBackupDisabledWarning)
+	}
+	return nil
+}
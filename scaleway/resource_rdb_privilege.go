@@ -67,7 +67,11 @@ func resourceScalewayRdbPrivilegeCreate(ctx context.Context, d *schema.ResourceD
 		return diag.FromErr(err)
 	}
 
-	_, err = waitInstance(ctx, rdbAPI, region, instanceID)
+	// Privileges of the same instance are set one after the other, see rdbInstanceLocks
+	unlock := rdbInstanceLocks.lock(instanceID)
+	defer unlock()
+
+	_, err = waitInstance(ctx, meta, rdbAPI, region, instanceID)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -86,7 +90,7 @@ func resourceScalewayRdbPrivilegeCreate(ctx context.Context, d *schema.ResourceD
 		_, errSetPrivilege := rdbAPI.SetPrivilege(createReq, scw.WithContext(ctx))
 		if errSetPrivilege != nil {
 			if is409Error(errSetPrivilege) {
-				_, errWait := waitInstance(ctx, rdbAPI, region, instanceID)
+				_, errWait := waitInstance(ctx, meta, rdbAPI, region, instanceID)
 				if errWait != nil {
 					return resource.NonRetryableError(errWait)
 				}
@@ -100,7 +104,7 @@ func resourceScalewayRdbPrivilegeCreate(ctx context.Context, d *schema.ResourceD
 		return diag.FromErr(err)
 	}
 
-	_, err = waitInstance(ctx, rdbAPI, region, instanceID)
+	_, err = waitInstance(ctx, meta, rdbAPI, region, instanceID)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -119,7 +123,7 @@ func resourceScalewayRdbPrivilegeRead(ctx context.Context, d *schema.ResourceDat
 	dbName, _ := d.Get("database_name").(string)
 	userName, _ := d.Get("user_name").(string)
 
-	_, err = waitInstance(ctx, rdbAPI, region, instanceID)
+	_, err = waitInstance(ctx, meta, rdbAPI, region, instanceID)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -172,7 +176,10 @@ func resourceScalewayRdbPrivilegeUpdate(ctx context.Context, d *schema.ResourceD
 		return diag.FromErr(err)
 	}
 
-	_, err = waitInstance(ctx, rdbAPI, region, instanceID)
+	unlock := rdbInstanceLocks.lock(instanceID)
+	defer unlock()
+
+	_, err = waitInstance(ctx, meta, rdbAPI, region, instanceID)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -209,7 +216,7 @@ func resourceScalewayRdbPrivilegeUpdate(ctx context.Context, d *schema.ResourceD
 		_, errSet := rdbAPI.SetPrivilege(updateReq, scw.WithContext(ctx))
 		if errSet != nil {
 			if is409Error(errSet) {
-				_, errWait := waitInstance(ctx, rdbAPI, region, instanceID)
+				_, errWait := waitInstance(ctx, meta, rdbAPI, region, instanceID)
 				if errWait != nil {
 					return resource.NonRetryableError(errWait)
 				}
@@ -223,7 +230,7 @@ func resourceScalewayRdbPrivilegeUpdate(ctx context.Context, d *schema.ResourceD
 		return diag.FromErr(err)
 	}
 
-	_, err = waitInstance(ctx, rdbAPI, region, instanceID)
+	_, err = waitInstance(ctx, meta, rdbAPI, region, instanceID)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -238,7 +245,10 @@ func resourceScalewayRdbPrivilegeDelete(ctx context.Context, d *schema.ResourceD
 		return diag.FromErr(err)
 	}
 
-	_, err = waitInstance(ctx, rdbAPI, region, instanceID)
+	unlock := rdbInstanceLocks.lock(instanceID)
+	defer unlock()
+
+	_, err = waitInstance(ctx, meta, rdbAPI, region, instanceID)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -294,7 +304,7 @@ func resourceScalewayRdbPrivilegeDelete(ctx context.Context, d *schema.ResourceD
 		_, errSet := rdbAPI.SetPrivilege(updateReq, scw.WithContext(ctx))
 		if errSet != nil {
 			if is409Error(errSet) {
-				_, errWait := waitInstance(ctx, rdbAPI, region, instanceID)
+				_, errWait := waitInstance(ctx, meta, rdbAPI, region, instanceID)
 				if errWait != nil {
 					return resource.NonRetryableError(errWait)
 				}
@@ -308,7 +318,7 @@ func resourceScalewayRdbPrivilegeDelete(ctx context.Context, d *schema.ResourceD
 		return diag.FromErr(err)
 	}
 
-	_, err = waitInstance(ctx, rdbAPI, region, instanceID)
+	_, err = waitInstance(ctx, meta, rdbAPI, region, instanceID)
 	if err != nil {
 		return diag.FromErr(err)
 	}
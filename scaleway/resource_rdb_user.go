@@ -68,7 +68,7 @@ func resourceScalewayRdbUserCreate(ctx context.Context, d *schema.ResourceData,
 		diag.FromErr(err)
 	}
 
-	ins, err := waitInstance(ctx, rdbAPI, region, instanceID)
+	ins, err := waitInstance(ctx, meta, rdbAPI, region, instanceID)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -87,7 +87,7 @@ func resourceScalewayRdbUserCreate(ctx context.Context, d *schema.ResourceData,
 		currentUser, errCreateUser := rdbAPI.CreateUser(createReq, scw.WithContext(ctx))
 		if errCreateUser != nil {
 			if is409Error(errCreateUser) {
-				_, errWait := waitInstance(ctx, rdbAPI, region, ins.ID)
+				_, errWait := waitInstance(ctx, meta, rdbAPI, region, ins.ID)
 				if errWait != nil {
 					return resource.NonRetryableError(errWait)
 				}
@@ -115,7 +115,7 @@ func resourceScalewayRdbUserRead(ctx context.Context, d *schema.ResourceData, me
 		return diag.FromErr(err)
 	}
 
-	_, err = waitInstance(ctx, rdbAPI, region, instanceID)
+	_, err = waitInstance(ctx, meta, rdbAPI, region, instanceID)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -152,7 +152,7 @@ func resourceScalewayRdbUserUpdate(ctx context.Context, d *schema.ResourceData,
 		return diag.FromErr(err)
 	}
 
-	_, err = waitInstance(ctx, rdbAPI, region, instanceID)
+	_, err = waitInstance(ctx, meta, rdbAPI, region, instanceID)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -186,7 +186,7 @@ func resourceScalewayRdbUserDelete(ctx context.Context, d *schema.ResourceData,
 		return diag.FromErr(err)
 	}
 
-	_, err = waitInstance(ctx, rdbAPI, region, instanceID)
+	_, err = waitInstance(ctx, meta, rdbAPI, region, instanceID)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -199,7 +199,7 @@ func resourceScalewayRdbUserDelete(ctx context.Context, d *schema.ResourceData,
 		}, scw.WithContext(ctx))
 		if errDeleteUser != nil {
 			if is409Error(errDeleteUser) {
-				_, errWait := waitInstance(ctx, rdbAPI, region, instanceID)
+				_, errWait := waitInstance(ctx, meta, rdbAPI, region, instanceID)
 				if errWait != nil {
 					return resource.NonRetryableError(errWait)
 				}
@@ -96,12 +96,12 @@ func resourceScalewayVPCGatewayNetworkCreate(ctx context.Context, d *schema.Reso
 		return diag.FromErr(err)
 	}
 
-	retryInterval := retryGWTimeout
+	retryInterval := waitRetryInterval(meta, retryGWTimeout)
 	gatewayID := expandZonedID(d.Get("gateway_id").(string)).ID
 	gw, err := vpcgwNetworkAPI.WaitForGateway(&vpcgw.WaitForGatewayRequest{
 		GatewayID:     gatewayID,
 		Timeout:       scw.TimeDurationPtr(defaultVPCGatewayTimeout),
-		RetryInterval: &retryInterval,
+		RetryInterval: retryInterval,
 		Zone:          zone,
 	}, scw.WithContext(ctx))
 	// check err waiting process
@@ -139,7 +139,7 @@ func resourceScalewayVPCGatewayNetworkCreate(ctx context.Context, d *schema.Reso
 	gw, err = vpcgwNetworkAPI.WaitForGateway(&vpcgw.WaitForGatewayRequest{
 		GatewayID:     gatewayID,
 		Timeout:       scw.TimeDurationPtr(defaultVPCGatewayTimeout),
-		RetryInterval: &retryInterval,
+		RetryInterval: retryInterval,
 		Zone:          zone,
 	}, scw.WithContext(ctx))
 	// check err waiting process
@@ -147,11 +147,11 @@ func resourceScalewayVPCGatewayNetworkCreate(ctx context.Context, d *schema.Reso
 		return diag.FromErr(err)
 	}
 
-	retryInterval = retryIntervalVPCGatewayNetwork
+	retryInterval = waitRetryInterval(meta, retryIntervalVPCGatewayNetwork)
 	gatewayNetwork, err = vpcgwNetworkAPI.WaitForGatewayNetwork(&vpcgw.WaitForGatewayNetworkRequest{
 		GatewayNetworkID: gatewayNetwork.ID,
 		Timeout:          scw.TimeDurationPtr(defaultVPCGatewayTimeout),
-		RetryInterval:    &retryInterval,
+		RetryInterval:    retryInterval,
 		Zone:             zone,
 	}, scw.WithContext(ctx))
 	if err != nil {
@@ -175,11 +175,11 @@ func resourceScalewayVPCGatewayNetworkRead(ctx context.Context, d *schema.Resour
 	}
 
 	gatewayID := expandZonedID(d.Get("gateway_id").(string)).ID
-	retryInterval := retryGWTimeout
+	retryInterval := waitRetryInterval(meta, retryGWTimeout)
 	_, err = vpcgwNetworkAPI.WaitForGateway(&vpcgw.WaitForGatewayRequest{
 		GatewayID:     gatewayID,
 		Timeout:       scw.TimeDurationPtr(defaultVPCGatewayTimeout),
-		RetryInterval: &retryInterval,
+		RetryInterval: retryInterval,
 		Zone:          zone,
 	}, scw.WithContext(ctx))
 	// check err waiting process
@@ -218,11 +218,11 @@ func resourceScalewayVPCGatewayNetworkRead(ctx context.Context, d *schema.Resour
 		cleanUpDHCPValue = *expandBoolPtr(cleanUpDHCP)
 	}
 
-	retryInterval = retryIntervalVPCGatewayNetwork
+	retryInterval = waitRetryInterval(meta, retryIntervalVPCGatewayNetwork)
 	gatewayNetwork, err = vpcgwNetworkAPI.WaitForGatewayNetwork(&vpcgw.WaitForGatewayNetworkRequest{
 		GatewayNetworkID: gatewayNetwork.ID,
 		Timeout:          scw.TimeDurationPtr(defaultVPCGatewayTimeout),
-		RetryInterval:    &retryInterval,
+		RetryInterval:    retryInterval,
 		Zone:             zone,
 	}, scw.WithContext(ctx))
 	if err != nil {
@@ -247,11 +247,11 @@ func resourceScalewayVPCGatewayNetworkUpdate(ctx context.Context, d *schema.Reso
 	}
 
 	gatewayID := expandZonedID(d.Get("gateway_id").(string)).ID
-	retryInterval := retryGWTimeout
+	retryInterval := waitRetryInterval(meta, retryGWTimeout)
 	_, err = vpcgwAPI.WaitForGateway(&vpcgw.WaitForGatewayRequest{
 		GatewayID:     gatewayID,
 		Timeout:       scw.TimeDurationPtr(defaultVPCGatewayTimeout),
-		RetryInterval: &retryInterval,
+		RetryInterval: retryInterval,
 		Zone:          zone,
 	}, scw.WithContext(ctx))
 	// check err waiting process
@@ -259,11 +259,11 @@ func resourceScalewayVPCGatewayNetworkUpdate(ctx context.Context, d *schema.Reso
 		return diag.FromErr(err)
 	}
 
-	retryInterval = retryIntervalVPCGatewayNetwork
+	retryInterval = waitRetryInterval(meta, retryIntervalVPCGatewayNetwork)
 	_, err = vpcgwAPI.WaitForGatewayNetwork(&vpcgw.WaitForGatewayNetworkRequest{
 		GatewayNetworkID: ID,
 		Timeout:          scw.TimeDurationPtr(defaultVPCGatewayTimeout),
-		RetryInterval:    &retryInterval,
+		RetryInterval:    retryInterval,
 		Zone:             zone,
 	}, scw.WithContext(ctx))
 	if err != nil {
@@ -297,18 +297,18 @@ func resourceScalewayVPCGatewayNetworkUpdate(ctx context.Context, d *schema.Reso
 	_, err = vpcgwAPI.WaitForGatewayNetwork(&vpcgw.WaitForGatewayNetworkRequest{
 		GatewayNetworkID: ID,
 		Timeout:          scw.TimeDurationPtr(defaultVPCGatewayTimeout),
-		RetryInterval:    &retryInterval,
+		RetryInterval:    retryInterval,
 		Zone:             zone,
 	}, scw.WithContext(ctx))
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	retryInterval = retryGWTimeout
+	retryInterval = waitRetryInterval(meta, retryGWTimeout)
 	_, err = vpcgwAPI.WaitForGateway(&vpcgw.WaitForGatewayRequest{
 		GatewayID:     gatewayID,
 		Timeout:       scw.TimeDurationPtr(defaultVPCGatewayTimeout),
-		RetryInterval: &retryInterval,
+		RetryInterval: retryInterval,
 		Zone:          zone,
 	}, scw.WithContext(ctx))
 	// check err waiting process
@@ -326,11 +326,11 @@ func resourceScalewayVPCGatewayNetworkDelete(ctx context.Context, d *schema.Reso
 	}
 
 	gatewayID := expandZonedID(d.Get("gateway_id").(string)).ID
-	retryInterval := retryGWTimeout
+	retryInterval := waitRetryInterval(meta, retryGWTimeout)
 	_, err = vpcgwAPI.WaitForGateway(&vpcgw.WaitForGatewayRequest{
 		GatewayID:     gatewayID,
 		Timeout:       scw.TimeDurationPtr(defaultVPCGatewayTimeout),
-		RetryInterval: &retryInterval,
+		RetryInterval: retryInterval,
 		Zone:          zone,
 	}, scw.WithContext(ctx))
 	// check err waiting process
@@ -338,11 +338,11 @@ func resourceScalewayVPCGatewayNetworkDelete(ctx context.Context, d *schema.Reso
 		return diag.FromErr(err)
 	}
 
-	retryInterval = retryIntervalVPCGatewayNetwork
+	retryInterval = waitRetryInterval(meta, retryIntervalVPCGatewayNetwork)
 	gwNetwork, err := vpcgwAPI.WaitForGatewayNetwork(&vpcgw.WaitForGatewayNetworkRequest{
 		GatewayNetworkID: ID,
 		Timeout:          scw.TimeDurationPtr(defaultVPCGatewayTimeout),
-		RetryInterval:    &retryInterval,
+		RetryInterval:    retryInterval,
 		Zone:             zone,
 	}, scw.WithContext(ctx))
 	if err != nil {
@@ -361,11 +361,11 @@ func resourceScalewayVPCGatewayNetworkDelete(ctx context.Context, d *schema.Reso
 	}
 
 	//check gateway is in stable state.
-	retryInterval = retryGWTimeout
+	retryInterval = waitRetryInterval(meta, retryGWTimeout)
 	_, err = vpcgwAPI.WaitForGateway(&vpcgw.WaitForGatewayRequest{
 		GatewayID:     gatewayID,
 		Timeout:       scw.TimeDurationPtr(defaultVPCGatewayTimeout),
-		RetryInterval: &retryInterval,
+		RetryInterval: retryInterval,
 		Zone:          zone,
 	}, scw.WithContext(ctx))
 	if err != nil && !is404Error(err) {
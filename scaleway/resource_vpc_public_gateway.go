@@ -104,11 +104,11 @@ func resourceScalewayVPCPublicGatewayCreate(ctx context.Context, d *schema.Resou
 
 	d.SetId(newZonedIDString(zone, res.ID))
 
-	retryInterval := retryGWTimeout
+	retryInterval := waitRetryInterval(meta, retryGWTimeout)
 	_, err = vpcgwAPI.WaitForGateway(&vpcgw.WaitForGatewayRequest{
 		GatewayID:     res.ID,
 		Timeout:       scw.TimeDurationPtr(defaultVPCGatewayTimeout),
-		RetryInterval: &retryInterval,
+		RetryInterval: retryInterval,
 		Zone:          zone,
 	}, scw.WithContext(ctx))
 	// check err waiting process
@@ -125,11 +125,11 @@ func resourceScalewayVPCPublicGatewayRead(ctx context.Context, d *schema.Resourc
 		return diag.FromErr(err)
 	}
 
-	retryInterval := retryGWTimeout
+	retryInterval := waitRetryInterval(meta, retryGWTimeout)
 	gateway, err := vpcgwAPI.WaitForGateway(&vpcgw.WaitForGatewayRequest{
 		GatewayID:     ID,
 		Timeout:       scw.TimeDurationPtr(defaultVPCGatewayTimeout),
-		RetryInterval: &retryInterval,
+		RetryInterval: retryInterval,
 		Zone:          zone,
 	}, scw.WithContext(ctx))
 	if err != nil {
@@ -155,11 +155,11 @@ func resourceScalewayVPCPublicGatewayUpdate(ctx context.Context, d *schema.Resou
 		return diag.FromErr(err)
 	}
 
-	retryInterval := retryGWTimeout
+	retryInterval := waitRetryInterval(meta, retryGWTimeout)
 	gateway, err := vpcgwAPI.WaitForGateway(&vpcgw.WaitForGatewayRequest{
 		GatewayID:     ID,
 		Timeout:       scw.TimeDurationPtr(defaultVPCGatewayTimeout),
-		RetryInterval: &retryInterval,
+		RetryInterval: retryInterval,
 		Zone:          zone,
 	}, scw.WithContext(ctx))
 	if err != nil {
@@ -184,7 +184,7 @@ func resourceScalewayVPCPublicGatewayUpdate(ctx context.Context, d *schema.Resou
 	_, err = vpcgwAPI.WaitForGateway(&vpcgw.WaitForGatewayRequest{
 		GatewayID:     ID,
 		Timeout:       scw.TimeDurationPtr(defaultVPCGatewayTimeout),
-		RetryInterval: &retryInterval,
+		RetryInterval: retryInterval,
 		Zone:          zone,
 	}, scw.WithContext(ctx))
 	if err != nil {
@@ -200,11 +200,11 @@ func resourceScalewayVPCPublicGatewayDelete(ctx context.Context, d *schema.Resou
 		return diag.FromErr(err)
 	}
 
-	retryInterval := retryGWTimeout
+	retryInterval := waitRetryInterval(meta, retryGWTimeout)
 	_, err = vpcgwAPI.WaitForGateway(&vpcgw.WaitForGatewayRequest{
 		GatewayID:     ID,
 		Timeout:       scw.TimeDurationPtr(defaultVPCGatewayTimeout),
-		RetryInterval: &retryInterval,
+		RetryInterval: retryInterval,
 		Zone:          zone,
 	}, scw.WithContext(ctx))
 	if err != nil {